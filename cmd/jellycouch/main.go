@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
 	"path/filepath"
@@ -12,32 +13,114 @@ import (
 	"github.com/depeter/jellycouch/internal/app"
 	"github.com/depeter/jellycouch/internal/cache"
 	"github.com/depeter/jellycouch/internal/config"
+	"github.com/depeter/jellycouch/internal/instance"
 	"github.com/depeter/jellycouch/internal/jellyfin"
 	"github.com/depeter/jellycouch/internal/jellyseerr"
+	"github.com/depeter/jellycouch/internal/logging"
+	"github.com/depeter/jellycouch/internal/netlog"
 	"github.com/depeter/jellycouch/internal/ui"
 )
 
 func main() {
+	playID := flag.String("play", "", "play the Jellyfin item with this `ID`")
+	itemID := flag.String("item", "", "open the detail screen of the Jellyfin item with this `ID`")
+	configDir := flag.String("config-dir", "", "keep config, logs and cache in `dir`")
+	portable := flag.Bool("portable", false, "keep config, logs and cache in jellycouch-data next to the executable")
+	flag.Parse()
+
+	if *portable && *configDir == "" {
+		dir, err := config.PortableDir()
+		if err != nil {
+			log.Fatalf("Portable mode: %v", err)
+		}
+		*configDir = dir
+	}
+	if *configDir != "" {
+		if err := config.SetConfigDir(*configDir); err != nil {
+			log.Fatalf("Config dir %s: %v", *configDir, err)
+		}
+	}
+
 	// Load config
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	// Hand the command line to a running instance, if there is one
+	launch := instance.Command{}
+	switch {
+	case *playID != "":
+		launch = instance.Command{Action: "play", ItemID: *playID}
+	case *itemID != "":
+		launch = instance.Command{Action: "item", ItemID: *itemID}
+	}
+	socketPath, socketErr := config.SocketPath()
+	if socketErr == nil && instance.Forward(socketPath, launch) == nil {
+		log.Printf("JellyCouch is already running; passed the command on to it")
+		return
+	}
+
+	// Log to stderr and a rotating file in the config dir
+	if logPath, err := config.LogPath(); err == nil {
+		logFile, err := logging.Setup(logPath, cfg.Log.Level, cfg.Log.MaxSizeMB)
+		if err != nil {
+			log.Printf("Logging to stderr only: %v", err)
+		}
+		defer logFile.Close()
+	}
+
 	// Init fonts
-	if err := ui.InitFonts(fonts.LiberationSans); err != nil {
+	fontData := fonts.LiberationSans
+	if cfg.UI.Font != "" {
+		if data, err := os.ReadFile(cfg.UI.Font); err != nil {
+			log.Printf("Font %s: %v; using the built-in font", cfg.UI.Font, err)
+		} else {
+			fontData = data
+		}
+	}
+	if err := ui.InitFonts(fontData); err != nil {
 		log.Fatalf("Failed to init fonts: %v", err)
 	}
+	ui.LoadFallbackFonts(cfg.UI.FallbackFonts)
+	ui.SetLanguage(cfg.UI.Language)
+	ui.SetLocale(cfg.UI.Locale)
+	ui.ContentScrim = cfg.UI.ContentScrim
+	ui.BackdropDim = cfg.UI.BackdropDim
+	ui.HitPadding = cfg.UI.HitPadding
+	ui.OnScreenKeyboardEnabled = cfg.UI.OnScreenKeyboard
+	ui.GridColumns = cfg.UI.Columns
+	ui.CornerRadius = cfg.UI.CornerRadius
+	ui.PosterFocusStyle = ui.ParseFocusStyle(cfg.UI.FocusStyle)
+	ui.AnimateFocusZoom = cfg.UI.AnimateFocus
+	ui.AmbientBackdrop = cfg.UI.AmbientBackdrop
+	ui.DimWatched = cfg.UI.DimWatched
+	ui.PosterRightClick = ui.ParseRightClickAction(cfg.UI.RightClickAction)
+	ui.ConfirmExit = cfg.UI.ConfirmExit
+	ui.SetRemoteDevices(cfg.Input.RemoteDevices)
+	ui.SetRemoteKeyCodes(cfg.Input.RemoteKeys)
+
+	netlog.MaxRequestsPerHost = cfg.Server.MaxRequests
+	if cfg.Server.EnsureDeviceID() {
+		if err := cfg.Save(); err != nil {
+			log.Printf("Failed to save config: %v", err)
+		}
+	}
+	jellyfin.SetDevice(cfg.Server.Device(), cfg.Server.DeviceID)
+	if err := netlog.ConfigureTLS(cfg.Server.CAFile, cfg.Server.InsecureSkipVerify); err != nil {
+		log.Printf("TLS: %v", err)
+	}
 
 	// Init image cache
 	cacheDir := filepath.Join(os.TempDir(), "jellycouch", "images")
-	if configDir, err := config.ConfigDir(); err == nil {
-		cacheDir = filepath.Join(configDir, "cache", "images")
+	if dir, err := cfg.ImageCacheDir(); err == nil {
+		cacheDir = dir
 	}
 	imgCache, err := cache.NewImageCache(cacheDir)
 	if err != nil {
 		log.Fatalf("Failed to init image cache: %v", err)
 	}
+	imgCache.ExtractAccents = cfg.UI.AccentColors
 
 	// Create game
 	var client *jellyfin.Client
@@ -57,6 +140,22 @@ func main() {
 
 	sf := &screenFactory{game: game, cfg: cfg, imgCache: imgCache}
 
+	// Take commands from later launches
+	game.OnOpenItem = func(itemID string, play bool) {
+		if game.Client == nil {
+			return
+		}
+		game.Screens.ClearStack()
+		sf.openLaunchItem(itemID, play)
+	}
+	if socketErr == nil {
+		if ln, err := instance.Listen(socketPath, game.HandleCommand); err != nil {
+			log.Printf("Single instance: %v", err)
+		} else {
+			defer ln.Close()
+		}
+	}
+
 	// Create and wire the global navbar
 	navbar := ui.NewNavBar()
 	navbar.OnNavigate = func(action, id, title string) {
@@ -67,7 +166,11 @@ func main() {
 		case "library":
 			game.Screens.ClearStack()
 			sf.pushHome()
-			sf.pushLibrary(id, title, nil)
+			if id != "" && id == sf.playlistsViewID {
+				sf.pushPlaylists(nil)
+			} else {
+				sf.pushLibrary(id, title, nil)
+			}
 		case "discovery":
 			game.Screens.ClearStack()
 			sf.pushHome()
@@ -92,12 +195,17 @@ func main() {
 	if client == nil || cfg.Server.Token == "" {
 		sf.pushLogin(navbar)
 	} else {
-		// Validate token before showing home screen
-		if _, err := client.GetViews(); err != nil {
+		// Validate token before showing the start screen
+		if views, err := client.GetViews(); err != nil {
 			log.Printf("Token invalid, showing login: %v", err)
 			sf.pushLogin(navbar)
 		} else {
-			sf.pushHome()
+			reportCapabilities(client)
+			if launch.ItemID != "" {
+				sf.openLaunchItem(launch.ItemID, launch.Action == "play")
+			} else {
+				sf.pushStartScreen(cfg.UI.StartScreen, views)
+			}
 			sf.loadNavBarViews()
 		}
 	}
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/depeter/jellycouch/internal/app"
 	"github.com/depeter/jellycouch/internal/cache"
 	"github.com/depeter/jellycouch/internal/config"
 	"github.com/depeter/jellycouch/internal/jellyfin"
 	"github.com/depeter/jellycouch/internal/jellyseerr"
+	"github.com/depeter/jellycouch/internal/logging"
+	"github.com/depeter/jellycouch/internal/netlog"
 	"github.com/depeter/jellycouch/internal/ui"
 )
 
@@ -16,16 +21,27 @@ type screenFactory struct {
 	game     *app.Game
 	cfg      *config.Config
 	imgCache *cache.ImageCache
+
+	playlistsViewID string // ID of the "Playlists" library view, if the server has one
 }
 
 func (sf *screenFactory) pushLogin(navbar *ui.NavBar) {
 	loginScreen := ui.NewLoginScreen(sf.cfg.Server.URL, func(screen *ui.LoginScreen, server, user, pass string) {
 		screen.Busy = true
 		screen.Error = ""
+		// Sign in as a device of our own rather than the ID all installs
+		// once shared
+		if sf.cfg.Server.DeviceID == config.LegacyDeviceID {
+			sf.cfg.Server.DeviceID = config.NewDeviceID()
+			jellyfin.SetDevice(sf.cfg.Server.Device(), sf.cfg.Server.DeviceID)
+		}
 		go func() {
 			c := jellyfin.NewClient(server)
 			if err := c.Authenticate(user, pass); err != nil {
 				screen.Error = "Login failed: " + err.Error()
+				if hint := netlog.TLSErrorHint(err); hint != "" {
+					screen.Error = "Login failed: " + hint
+				}
 				screen.Busy = false
 				return
 			}
@@ -36,6 +52,7 @@ func (sf *screenFactory) pushLogin(navbar *ui.NavBar) {
 			sf.cfg.Save()
 
 			sf.game.Client = c
+			reportCapabilities(c)
 			screen.Busy = false
 			sf.pushHome()
 			sf.loadNavBarViews()
@@ -46,18 +63,117 @@ func (sf *screenFactory) pushLogin(navbar *ui.NavBar) {
 
 func (sf *screenFactory) pushHome() {
 	home := ui.NewHomeScreen(sf.game.Client, sf.imgCache)
-	home.OnItemSelected = func(item jellyfin.MediaItem) {
-		sf.pushDetail(item)
-	}
+	home.OnItemSelected = sf.openItem
 	home.OnLibraryBrowse = func(parentID, title string) {
 		sf.pushLibrary(parentID, title, nil)
 	}
 	home.OnAuthError = func() {
 		sf.pushLogin(sf.game.Screens.NavBar)
 	}
+	home.OnPlay = sf.play
+	home.IsLatestHidden = sf.cfg.UI.IsLatestHidden
+	if sf.game.Jellyseerr != nil {
+		home.OnRequest4K = sf.pushJellyseerrRequest4K
+	}
 	sf.game.Screens.Replace(home)
 }
 
+// reportCapabilities registers the session's capabilities with the server in
+// the background after signing in.
+func reportCapabilities(c *jellyfin.Client) {
+	go func() {
+		if err := c.ReportCapabilities(); err != nil {
+			log.Printf("Failed to report capabilities: %v", err)
+		}
+	}()
+}
+
+// pushStartScreen shows the configured landing screen on top of Home, so Back
+// always ends up there. Targets that no longer exist fall back to Home.
+func (sf *screenFactory) pushStartScreen(start string, views []jellyfin.MediaItem) {
+	sf.pushHome()
+
+	switch {
+	case start == "" || start == "home":
+	case strings.HasPrefix(start, "library:"):
+		id := strings.TrimPrefix(start, "library:")
+		for _, v := range views {
+			if v.ID != id {
+				continue
+			}
+			if v.CollectionType == "playlists" {
+				sf.pushPlaylists(nil)
+			} else {
+				sf.pushLibrary(v.ID, v.Name, nil)
+			}
+			return
+		}
+		log.Printf("Start screen: library %s not found, showing Home", id)
+	case start == "discovery":
+		if sf.game.Jellyseerr == nil {
+			log.Printf("Start screen: Jellyseerr not configured, showing Home")
+			return
+		}
+		sf.pushJellyseerrDiscover()
+	case start == "continue":
+		items, err := sf.game.Client.GetResumeItems(1)
+		if err != nil {
+			log.Printf("Start screen: failed to load Continue Watching: %v", err)
+			return
+		}
+		if len(items) > 0 {
+			sf.pushDetail(items[0])
+		}
+	default:
+		log.Printf("Start screen: unknown value %q, showing Home", start)
+	}
+}
+
+// openLaunchItem shows the item named on the command line on top of Home:
+// its detail screen, and with play set its playback too. An unknown ID or a
+// failed fetch leaves Home with a message. The item is fetched in the
+// background and opened from Update.
+func (sf *screenFactory) openLaunchItem(itemID string, play bool) {
+	sf.pushHome()
+	client := sf.game.Client
+	go func() {
+		item, err := client.GetItem(itemID)
+		sf.game.RunInUpdate(func() {
+			switch {
+			case errors.Is(err, jellyfin.ErrNotFound):
+				log.Printf("Open item %s: %v", itemID, err)
+				sf.game.Screens.ShowMessage("Item not found",
+					fmt.Sprintf("The server has no item with ID %s.", itemID), err.Error())
+				return
+			case err != nil:
+				log.Printf("Open item %s: %v", itemID, err)
+				sf.game.Screens.ShowMessage("Couldn't open item",
+					fmt.Sprintf("Item %s could not be loaded from the server.", itemID), err.Error())
+				return
+			}
+			sf.pushDetail(*item)
+			if play {
+				sf.game.PlayOnStart(*item)
+			}
+		})
+	}()
+}
+
+// openItem opens the screen for a selected item: playlists get the playlist
+// screen, everything else the detail screen.
+func (sf *screenFactory) openItem(item jellyfin.MediaItem) {
+	if item.Type == "Playlist" {
+		sf.pushPlaylists(&item)
+		return
+	}
+	sf.pushDetail(item)
+}
+
+// play starts playback of a Jellyfin item from the given position.
+func (sf *screenFactory) play(item jellyfin.MediaItem, resumeTicks int64) {
+	sf.game.StartPlayback(item.ID, resumeTicks, &item)
+}
+
 func (sf *screenFactory) pushDetail(item jellyfin.MediaItem) {
 	detail := ui.NewDetailScreen(sf.game.Client, sf.imgCache, item)
 	detail.OnPlay = func(item jellyfin.MediaItem, resumeTicks int64) {
@@ -66,21 +182,58 @@ func (sf *screenFactory) pushDetail(item jellyfin.MediaItem) {
 	detail.OnLibrary = func(parentID, title string) {
 		sf.pushLibrary(parentID, title, nil)
 	}
+	detail.OnPerson = sf.pushPerson
 	sf.game.Screens.Push(detail)
 }
 
+func (sf *screenFactory) pushPerson(person jellyfin.Person) {
+	personScreen := ui.NewPersonScreen(sf.game.Client, sf.imgCache, person)
+	personScreen.OnItemSelected = sf.openItem
+	sf.game.Screens.Push(personScreen)
+}
+
 func (sf *screenFactory) pushLibrary(parentID, title string, itemTypes []string) {
 	lib := ui.NewLibraryScreen(sf.game.Client, sf.imgCache, parentID, title, itemTypes)
-	lib.OnItemSelected = func(item jellyfin.MediaItem) {
-		sf.pushDetail(item)
+	if parentID != "" {
+		if status, ok := sf.cfg.UI.LibraryStatus[parentID]; ok {
+			lib.SetStatus(status)
+		}
+		lib.OnStatusChanged = func(status string) {
+			sf.cfg.UI.RememberLibraryStatus(parentID, status)
+			if err := sf.cfg.Save(); err != nil {
+				log.Printf("Failed to save config: %v", err)
+			}
+		}
+	}
+	lib.OnItemSelected = sf.openItem
+	lib.OnPlay = sf.play
+	if sf.game.Jellyseerr != nil {
+		lib.OnRequest4K = sf.pushJellyseerrRequest4K
 	}
 	sf.game.Screens.Push(lib)
 }
 
+// pushPlaylists opens a playlist, or the list of all playlists if nil.
+func (sf *screenFactory) pushPlaylists(playlist *jellyfin.MediaItem) {
+	ps := ui.NewPlaylistScreen(sf.game.Client, sf.imgCache, playlist)
+	ps.OnPlaylistSelected = func(item jellyfin.MediaItem) {
+		sf.pushPlaylists(&item)
+	}
+	ps.OnItemSelected = func(item jellyfin.MediaItem) {
+		sf.pushDetail(item)
+	}
+	ps.OnPlayAll = func(items []jellyfin.MediaItem) {
+		sf.game.PlayQueue(items)
+	}
+	sf.game.Screens.Push(ps)
+}
+
 func (sf *screenFactory) pushSearch(query string) {
 	search := ui.NewSearchScreen(sf.game.Client, sf.imgCache)
-	search.OnItemSelected = func(item jellyfin.MediaItem) {
-		sf.pushDetail(item)
+	search.OnItemSelected = sf.openItem
+	search.OnPlay = sf.play
+	if sf.game.Jellyseerr != nil {
+		search.OnRequest4K = sf.pushJellyseerrRequest4K
 	}
 	if query != "" {
 		search.SetInitialQuery(query)
@@ -91,24 +244,51 @@ func (sf *screenFactory) pushSearch(query string) {
 func (sf *screenFactory) pushSettings() {
 	settings := ui.NewSettingsScreen(sf.cfg, func() {
 		sf.cfg.Save()
-		if sf.cfg.Jellyseerr.URL != "" && sf.cfg.Jellyseerr.APIKey != "" {
-			sf.game.Jellyseerr = jellyseerr.NewClient(sf.cfg.Jellyseerr.URL, sf.cfg.Jellyseerr.APIKey)
-		} else {
-			sf.game.Jellyseerr = nil
+		if err := logging.SetLevel(sf.cfg.Log.Level); err != nil {
+			log.Printf("Log level: %v", err)
 		}
+		sf.reconnectJellyseerr()
 		sf.loadNavBarViews()
 	})
+	settings.Client = sf.game.Client
+	settings.AddLatestToggles(sf.game.Screens.NavBar.LibraryViews)
+	settings.OnJellyseerrChanged = func() *jellyseerr.Client {
+		if err := sf.cfg.Save(); err != nil {
+			log.Printf("Failed to save config: %v", err)
+		}
+		return sf.reconnectJellyseerr()
+	}
 	sf.game.Screens.Push(settings)
 }
 
+// reconnectJellyseerr rebuilds the Jellyseerr client from the current config.
+// Returns nil (and disables Jellyseerr) when the URL or API key is missing or invalid.
+func (sf *screenFactory) reconnectJellyseerr() *jellyseerr.Client {
+	sf.game.Jellyseerr = nil
+	if sf.cfg.Jellyseerr.URL == "" || sf.cfg.Jellyseerr.APIKey == "" {
+		return nil
+	}
+	if _, err := jellyseerr.NormalizeURL(sf.cfg.Jellyseerr.URL); err != nil {
+		log.Printf("Jellyseerr disabled: %v", err)
+		return nil
+	}
+	sf.game.Jellyseerr = jellyseerr.NewClient(sf.cfg.Jellyseerr.URL, sf.cfg.Jellyseerr.APIKey)
+	return sf.game.Jellyseerr
+}
+
 func (sf *screenFactory) pushJellyseerrDiscover() {
 	if sf.game.Jellyseerr == nil {
 		return
 	}
 	discover := ui.NewJellyseerrDiscoverScreen(sf.game.Jellyseerr, sf.imgCache)
-	discover.OnItemSelected = func(result jellyseerr.SearchResult) {
-		sf.pushJellyseerrRequest(result)
+	discover.HideAvailable = sf.cfg.Jellyseerr.HideAvailable
+	discover.OnHideAvailableChanged = func(hide bool) {
+		sf.cfg.Jellyseerr.HideAvailable = hide
+		if err := sf.cfg.Save(); err != nil {
+			log.Printf("Failed to save config: %v", err)
+		}
 	}
+	discover.OnItemSelected = sf.openJellyseerrResult
 	discover.OnRequests = func() {
 		sf.pushJellyseerrRequests()
 	}
@@ -118,6 +298,26 @@ func (sf *screenFactory) pushJellyseerrDiscover() {
 	sf.game.Screens.Push(discover)
 }
 
+// openJellyseerrResult opens the library detail of a result that is already
+// in Jellyfin, and the Jellyseerr request screen otherwise. The library item
+// is fetched in the background and its screen pushed from Update.
+func (sf *screenFactory) openJellyseerrResult(result jellyseerr.SearchResult) {
+	if result.MediaInfo == nil || result.MediaInfo.JellyfinMediaID == "" || sf.game.Client == nil {
+		sf.pushJellyseerrRequest(result)
+		return
+	}
+	id := result.MediaInfo.JellyfinMediaID
+	go func() {
+		item, err := sf.game.Client.GetItem(id)
+		if err != nil {
+			log.Printf("Open %s in library: %v", result.DisplayTitle(), err)
+			sf.game.RunInUpdate(func() { sf.pushJellyseerrRequest(result) })
+			return
+		}
+		sf.game.RunInUpdate(func() { sf.pushDetail(*item) })
+	}()
+}
+
 func (sf *screenFactory) pushJellyseerrSearch() {
 	if sf.game.Jellyseerr == nil {
 		return
@@ -129,14 +329,54 @@ func (sf *screenFactory) pushJellyseerrSearch() {
 	sf.game.Screens.Push(search)
 }
 
+func (sf *screenFactory) pushJellyseerrPerson(person jellyseerr.SearchResult) {
+	personScreen := ui.NewJellyseerrPersonScreen(sf.game.Jellyseerr, sf.imgCache, person)
+	personScreen.OnItemSelected = func(result jellyseerr.SearchResult) {
+		sf.pushJellyseerrRequest(result)
+	}
+	sf.game.Screens.Push(personScreen)
+}
+
 func (sf *screenFactory) pushJellyseerrRequest(result jellyseerr.SearchResult) {
 	if sf.game.Jellyseerr == nil {
 		return
 	}
+	if result.MediaType == "person" {
+		sf.pushJellyseerrPerson(result)
+		return
+	}
+	sf.game.Screens.Push(sf.newJellyseerrRequestScreen(result))
+}
+
+// newJellyseerrRequestScreen creates a request screen that remembers the
+// chosen Radarr/Sonarr options in the config.
+func (sf *screenFactory) newJellyseerrRequestScreen(result jellyseerr.SearchResult) *ui.JellyseerrRequestScreen {
 	reqScreen := ui.NewJellyseerrRequestScreen(sf.game.Jellyseerr, sf.imgCache, result)
+	reqScreen.Defaults = &sf.cfg.Jellyseerr
+	reqScreen.OnDefaultsChanged = func() {
+		if err := sf.cfg.Save(); err != nil {
+			log.Printf("Failed to save config: %v", err)
+		}
+	}
 	reqScreen.OnPlayTrailer = func(url string) {
-		sf.game.PlayURL(url)
+		sf.game.PlayURL(url, true)
 	}
+	return reqScreen
+}
+
+// pushJellyseerrRequest4K opens the request screen for a library item in 4K.
+func (sf *screenFactory) pushJellyseerrRequest4K(tmdbID int, mediaType, title string) {
+	if sf.game.Jellyseerr == nil {
+		return
+	}
+	result := jellyseerr.SearchResult{ID: tmdbID, MediaType: mediaType}
+	if mediaType == "tv" {
+		result.Name = title
+	} else {
+		result.Title = title
+	}
+	reqScreen := sf.newJellyseerrRequestScreen(result)
+	reqScreen.RequestIn4K()
 	sf.game.Screens.Push(reqScreen)
 }
 
@@ -167,6 +407,9 @@ func (sf *screenFactory) loadNavBarViews() {
 		var libViews []struct{ ID, Name string }
 		for _, v := range views {
 			libViews = append(libViews, struct{ ID, Name string }{v.ID, v.Name})
+			if v.CollectionType == "playlists" {
+				sf.playlistsViewID = v.ID
+			}
 		}
 		sf.game.Screens.NavBar.LibraryViews = libViews
 	}()
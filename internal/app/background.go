@@ -0,0 +1,127 @@
+package app
+
+import (
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/depeter/jellycouch/internal/jellyfin"
+	"github.com/depeter/jellycouch/internal/ui"
+)
+
+// isAudio reports whether item is music or an audiobook, which keep playing
+// when the user goes back to browsing.
+func isAudio(item *jellyfin.MediaItem) bool {
+	return item.Type == "Audio" || item.Type == "AudioBook"
+}
+
+// enterBackgroundAudio returns to the browse screens with the current audio
+// still playing, video off so the UI owns the window. It plays on until it
+// ends or is stopped from the now-playing bar or the remote's Stop. The bar,
+// the remote's Play while playing and Ctrl+P take it back to full playback.
+func (g *Game) enterBackgroundAudio() {
+	if g.overlay != nil {
+		g.overlay.Hide()
+	}
+	if err := g.Player.SetVideo(false); err != nil {
+		log.Printf("Failed to disable video: %v", err)
+	}
+	g.nowPlaying.OnPlayPause = func() { g.Player.TogglePause() }
+	g.nowPlaying.OnStop = func() {
+		g.queue = nil
+		g.StopPlayback()
+	}
+	g.nowPlaying.OnOpen = g.leaveBackgroundAudio
+	g.sleepInhibit.Stop()
+	g.State = StateBrowseAudio
+}
+
+// leaveBackgroundAudio brings the background audio back to full playback
+// with the overlay.
+func (g *Game) leaveBackgroundAudio() {
+	if err := g.Player.SetVideo(true); err != nil {
+		log.Printf("Failed to enable video: %v", err)
+	}
+	if g.overlay != nil {
+		g.overlay.Show()
+	}
+	g.sleepInhibit.Start()
+	g.State = StatePlay
+}
+
+// updateBackgroundAudio keeps StateBrowseAudio playback going: it reports
+// progress, moves on through the queue and handles the remote's transport
+// buttons. Called every frame after the screens have updated.
+func (g *Game) updateBackgroundAudio() {
+	select {
+	case reason := <-g.playbackErr:
+		if g.retryTranscoded() {
+			return
+		}
+		title := "Playback failed"
+		if g.currentItem != nil {
+			title = "Couldn't play " + g.currentItem.Name
+		}
+		g.queue = nil
+		g.StopPlayback()
+		g.Screens.ShowMessage(title, reason, "")
+		return
+	default:
+	}
+
+	g.receiveNextEpisode()
+
+	if g.playbackEnded {
+		g.playbackEnded = false
+		next := g.nextEpItem
+		g.StopPlayback()
+		if next == nil {
+			g.queue = nil
+			return
+		}
+		g.StartPlayback(next.ID, 0, next)
+		if g.State == StatePlay && isAudio(next) {
+			g.enterBackgroundAudio()
+		}
+		return
+	}
+
+	g.reportProgress()
+
+	if ui.RemoteJustPressed(ui.RemoteStop) {
+		g.queue = nil
+		g.StopPlayback()
+		return
+	}
+	paused := g.Player.Paused()
+	if (ui.RemoteJustPressed(ui.RemotePlay) && !paused) || openPlaybackPressed() {
+		g.leaveBackgroundAudio()
+		return
+	}
+	if ui.RemoteJustPressed(ui.RemotePlayPause) ||
+		(ui.RemoteJustPressed(ui.RemotePlay) && paused) ||
+		(ui.RemoteJustPressed(ui.RemotePause) && !paused) {
+		g.Player.TogglePause()
+	}
+}
+
+// openPlaybackPressed reports whether Ctrl+P (Cmd+P on macOS) was pressed,
+// the keyboard's way back from the now-playing bar to full playback.
+func openPlaybackPressed() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyP) &&
+		(ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta))
+}
+
+// drawNowPlaying draws the mini-player for the background audio.
+func (g *Game) drawNowPlaying(screen *ebiten.Image) {
+	if g.currentItem != nil {
+		g.nowPlaying.Title = g.currentItem.Name
+	}
+	g.nowPlaying.Paused = g.Player.Paused()
+	g.nowPlaying.Progress = 0
+	if dur := g.Player.Duration(); dur > 0 {
+		g.nowPlaying.Progress = g.Player.Position() / dur
+	}
+	g.nowPlaying.Draw(screen)
+}
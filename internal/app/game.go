@@ -1,10 +1,12 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
@@ -12,6 +14,7 @@ import (
 	"github.com/depeter/jellycouch/internal/cache"
 	"github.com/depeter/jellycouch/internal/config"
 	"github.com/depeter/jellycouch/internal/constants"
+	"github.com/depeter/jellycouch/internal/instance"
 	"github.com/depeter/jellycouch/internal/jellyfin"
 	"github.com/depeter/jellycouch/internal/jellyseerr"
 	"github.com/depeter/jellycouch/internal/player"
@@ -30,16 +33,54 @@ type Game struct {
 	State         AppState
 	Width, Height int
 
+	// OnOpenItem shows the item a later launch asked for, and plays it
+	// when play is set. Called from Update.
+	OnOpenItem func(itemID string, play bool)
+	commands   chan instance.Command // from HandleCommand, run in Update
+	updates    chan func()           // from RunInUpdate
+
 	// Set to true when mpv playback ends and we need to return to browse mode
 	playbackEnded bool
+	// Receives the reason when mpv stops because the file couldn't be played
+	playbackErr chan string
 
 	overlay        *player.PlaybackOverlay
 	currentItem    *jellyfin.MediaItem
 	nextEpCh       chan *jellyfin.MediaItem
 	nextEpItem     *jellyfin.MediaItem // pre-fetched next episode for direct playback
 	nextEpBGRAPath string              // temp file for thumbnail overlay
+	queue          *player.Queue       // playlist being played through (nil = none)
+
+	lastProgressReport time.Time // last progress heartbeat sent to the server
+	markedPlayed       bool      // current item already passed the played threshold
+	startTicks         int64     // position the current item was started at
+	transcoding        bool      // current item fell back to a transcoded stream
+	autoPaused         bool      // paused by pause_on_focus_loss; resume on focus
+
+	sleepInhibit player.SleepInhibitor // keeps the screen on while StatePlay
+	nowPlaying   ui.NowPlayingBar      // mini-player shown in StateBrowseAudio
+
+	timecode  timecodeEntry         // "go to time" prompt state
+	bookmarks *player.BookmarkStore // local per-item bookmarks (nil if unavailable)
 
-	startFullscreen bool // apply fullscreen on first Update() frame
+	// Resume positions of videos played by URL (playback.resume_urls)
+	urlResume  *player.URLResumeStore // nil if unavailable
+	playingURL string                 // URL of the current PlayURL playback
+
+	// Volume to go back to once a trailer stops; set while a trailer plays
+	// at playback.trailer_volume.
+	normalVolume    int
+	trailerVolumeOn bool
+
+	startFullscreen bool                // apply fullscreen on first Update() frame
+	startItem       *jellyfin.MediaItem // play on first Update() frame (--play)
+
+	// Background mpv start-up (playback.preload_player). preloaded and
+	// preloadErr are written before preloadDone is closed.
+	preloadPending bool          // start the preload on the first Update() frame
+	preloadDone    chan struct{} // nil when no preload is running
+	preloaded      *player.Player
+	preloadErr     error
 }
 
 // NewGame creates the Game with all dependencies.
@@ -53,104 +94,366 @@ func NewGame(cfg *config.Config, client *jellyfin.Client, imgCache *cache.ImageC
 		Width:           cfg.UI.Width,
 		Height:          cfg.UI.Height,
 		startFullscreen: cfg.UI.Fullscreen,
+		preloadPending:  cfg.Playback.PreloadPlayer,
+		playbackErr:     make(chan string, 1),
+		commands:        make(chan instance.Command, 4),
+		updates:         make(chan func(), 8),
+	}
+	if path, err := config.BookmarksPath(); err == nil {
+		bs, err := player.LoadBookmarks(path)
+		if err != nil {
+			log.Printf("Failed to load bookmarks: %v", err)
+		}
+		g.bookmarks = bs
+	}
+	if path, err := config.URLResumePath(); err == nil {
+		rs, err := player.LoadURLResume(path)
+		if err != nil {
+			log.Printf("Failed to load URL resume positions: %v", err)
+		}
+		g.urlResume = rs
 	}
 	return g
 }
 
+// HandleCommand queues a command forwarded by a later launch. It is safe to
+// call from any goroutine; commands beyond a small backlog are dropped.
+func (g *Game) HandleCommand(cmd instance.Command) {
+	select {
+	case g.commands <- cmd:
+	default:
+		log.Printf("Dropped forwarded command %q", cmd.Action)
+	}
+}
+
+// RunInUpdate hands fn to the game thread, which runs it at the start of
+// the next Update. Background fetches use it to push the screens they
+// loaded. Call it only from other goroutines: it blocks while the backlog
+// is full.
+func (g *Game) RunInUpdate(fn func()) {
+	g.updates <- fn
+}
+
+// runCommand brings the window forward and opens the command's item,
+// stopping whatever is playing.
+func (g *Game) runCommand(cmd instance.Command) {
+	if ebiten.IsWindowMinimized() {
+		ebiten.RestoreWindow()
+	}
+	ebiten.RequestAttention()
+	if cmd.ItemID == "" || g.OnOpenItem == nil {
+		return
+	}
+	if g.State != StateBrowse {
+		g.StopPlayback()
+	}
+	g.OnOpenItem(cmd.ItemID, cmd.Action == "play")
+}
+
+// PlayOnStart plays item once the window is up, from where it was left off.
+func (g *Game) PlayOnStart(item jellyfin.MediaItem) {
+	g.startItem = &item
+}
+
 // InitPlayer creates the mpv player instance. Call after the window is visible.
+// If a preload is running it waits for that instead of starting a second mpv.
 func (g *Game) InitPlayer() error {
-	p, err := player.New(g.Config)
+	if g.Player != nil {
+		return nil
+	}
+	if g.preloadDone != nil {
+		<-g.preloadDone
+		g.preloadDone = nil
+		if g.preloadErr != nil {
+			return g.preloadErr // the next play tries again from scratch
+		}
+		g.Player = g.preloaded
+		g.preloaded = nil
+		return nil
+	}
+	p, err := g.newPlayer()
 	if err != nil {
 		return err
 	}
+	g.Player = p
+	return nil
+}
+
+// PreloadPlayer starts mpv on a background goroutine so the first playback
+// doesn't wait for it. InitPlayer picks the result up.
+func (g *Game) PreloadPlayer() {
+	if g.Player != nil || g.preloadDone != nil {
+		return
+	}
+	done := make(chan struct{})
+	g.preloadDone = done
+	go func() {
+		start := time.Now()
+		g.preloaded, g.preloadErr = g.newPlayer()
+		if g.preloadErr != nil {
+			log.Printf("Player preload failed: %v", g.preloadErr)
+		} else {
+			log.Printf("Player preloaded in %v", time.Since(start).Round(time.Millisecond))
+		}
+		close(done)
+	}()
+}
+
+func (g *Game) newPlayer() (*player.Player, error) {
+	p, err := player.New(g.Config)
+	if err != nil {
+		return nil, err
+	}
 	p.OnPlaybackEnd = func() {
 		g.playbackEnded = true
 	}
-	g.Player = p
-	return nil
+	p.OnPlaybackError = func(reason string) {
+		select {
+		case g.playbackErr <- reason:
+		default:
+		}
+	}
+	return p, nil
 }
 
-// StartPlayback transitions to play mode.
-func (g *Game) StartPlayback(itemID string, resumeTicks int64, item *jellyfin.MediaItem) {
+// playbackFailed logs why playback could not start and tells the user.
+func (g *Game) playbackFailed(title, message string, err error) {
+	log.Printf("%s: %v", title, err)
+	g.Screens.ShowMessage(title, message, err.Error())
+}
+
+// preparePlayer creates mpv if needed and points it at the window. Failures
+// are shown to the user.
+func (g *Game) preparePlayer() bool {
+	if g.State == StateBrowseAudio {
+		g.StopPlayback()
+	}
 	if g.Player == nil {
 		if err := g.InitPlayer(); err != nil {
-			log.Printf("Failed to init player: %v", err)
-			return
+			msg := "JellyCouch plays video with mpv, which could not be started."
+			if errors.Is(err, player.ErrUnavailable) {
+				msg = "mpv/libmpv was not found or failed to start. " + player.InstallHint()
+			}
+			g.playbackFailed("Video playback unavailable", msg, err)
+			return false
 		}
 	}
 
 	// Get window handle and set on mpv
 	wid, err := player.GetWindowHandle()
 	if err != nil {
-		log.Printf("Failed to get window handle: %v", err)
-		return
+		g.playbackFailed("Video playback unavailable", "Could not find the JellyCouch window to play video in.", err)
+		return false
 	}
 	if err := g.Player.SetWindowID(wid); err != nil {
 		log.Printf("Failed to set window ID: %v", err)
 	}
+	if err := g.Player.SetVideo(true); err != nil {
+		log.Printf("Failed to enable video: %v", err)
+	}
+	// The player is reused, so undo the last playback's picture adjustments
+	g.resetPicture()
 
+	// Drop an error left over from the previous file
+	select {
+	case <-g.playbackErr:
+	default:
+	}
+	return true
+}
+
+// resetPicture sets brightness, contrast and gamma back to the [playback]
+// defaults.
+func (g *Game) resetPicture() {
+	pb := g.Config.Playback
+	g.Player.SetBrightness(pb.Brightness)
+	g.Player.SetContrast(pb.Contrast)
+	g.Player.SetGamma(pb.Gamma)
+}
+
+// StartPlayback transitions to play mode.
+func (g *Game) StartPlayback(itemID string, resumeTicks int64, item *jellyfin.MediaItem) {
+	if !g.preparePlayer() {
+		return
+	}
+	g.restoreVolume()
 	streamURL := g.Client.GetStreamURL(itemID)
 	var startSec float64
 	if resumeTicks > 0 {
 		startSec = float64(resumeTicks) / constants.TicksPerSecond
+		// A negative or NaN rewind from a hand-edited config is ignored
+		if rewind := g.Config.Playback.ResumeRewindSeconds; rewind > 0 {
+			startSec = max(0, startSec-rewind)
+		}
 	}
 	if err := g.Player.LoadFile(streamURL, itemID, startSec); err != nil {
-		log.Printf("Failed to load file: %v", err)
+		g.playbackFailed("Playback failed", "mpv could not open this item.", err)
 		return
 	}
 
+	// Starting anything outside the queue ends it
+	if g.queue != nil && !g.queue.Jump(itemID) {
+		g.queue = nil
+	}
+
 	// Report playback start
 	go g.Client.ReportPlaybackStart(itemID, resumeTicks)
+	g.lastProgressReport = time.Now()
+	g.markedPlayed = false
+	g.startTicks = resumeTicks
+	g.transcoding = false
+	g.timecode = timecodeEntry{}
 
 	g.currentItem = item
 	g.nextEpCh = make(chan *jellyfin.MediaItem, 1)
 	g.nextEpItem = nil
 	g.nextEpBGRAPath = ""
 
-	g.overlay = player.NewPlaybackOverlay(g.Player, g.Width, g.Height)
-	g.overlay.OnStop = func() { g.StopPlayback() }
-	if item != nil && item.Type == "Episode" {
+	g.overlay = player.NewPlaybackOverlay(g.Player, g.Width, g.Height, g.Config.Playback)
+	g.overlay.FormatClock = ui.FormatClock
+	g.overlay.OnStop = func() {
+		g.queue = nil
+		g.StopPlayback()
+	}
+	g.overlay.Bookmarks = g.bookmarks
+	q := g.queue
+	hasNext := item != nil && item.Type == "Episode"
+	if q != nil {
+		_, hasNext = q.Peek()
+	}
+	if hasNext {
 		g.overlay.SetShowNextButton(true)
 		g.overlay.OnNextEpisode = func() { g.playNextEpisode() }
 		g.overlay.OnStartNextUp = func() { g.playNextEpisode() }
-		go g.prefetchNextEpisode(item)
+		go g.prefetchNextEpisode(item, q)
 	}
 	g.overlay.Show()
 
+	g.sleepInhibit.Start()
 	g.State = StatePlay
 	g.playbackEnded = false
 }
 
+// receiveNextEpisode takes the pre-fetched next-episode result once it
+// arrives and offers it on the overlay.
+func (g *Game) receiveNextEpisode() {
+	if g.nextEpCh == nil {
+		return
+	}
+	select {
+	case nextItem := <-g.nextEpCh:
+		g.nextEpCh = nil
+		if nextItem != nil {
+			if g.nextEpItem != nil {
+				// Already have a stored result — this shouldn't happen, ignore
+			} else {
+				g.nextEpItem = nextItem
+				if g.overlay != nil {
+					g.overlay.SetNextUp(nextItem.Name, nextItem.IndexNumber)
+				}
+			}
+		} else if g.Player != nil && g.State == StatePlay {
+			g.Player.ShowText("No next episode", 3000)
+		}
+	default:
+	}
+}
+
+// fallbackBitrate caps the transcoded stream used when direct play fails.
+const fallbackBitrate = 8_000_000
+
+// retryTranscoded reloads the current Jellyfin item as a transcoded stream
+// after direct play failed, e.g. because mpv can't decode the codec. It only
+// tries once per item and reports whether a retry was started.
+func (g *Game) retryTranscoded() bool {
+	itemID := g.Player.ItemID()
+	if itemID == "" || g.transcoding {
+		return false
+	}
+	g.transcoding = true
+
+	startSec := g.Player.Position()
+	if startSec <= 0 {
+		startSec = float64(g.startTicks) / constants.TicksPerSecond
+	}
+	streamURL := g.Client.GetStreamURLWithOptions(itemID, jellyfin.StreamOptions{
+		Transcode:  true,
+		MaxBitrate: fallbackBitrate,
+	})
+	log.Printf("Direct play of %s failed, retrying transcoded", itemID)
+	if err := g.Player.LoadFile(streamURL, itemID, startSec); err != nil {
+		log.Printf("Transcoded retry failed to load: %v", err)
+		return false
+	}
+	g.Player.ShowText("Switching to compatible stream\u2026", 3000)
+	g.playbackEnded = false
+	return true
+}
+
+// PlayQueue plays items back to back from the start of the first one,
+// advancing through the queue like next-episode playback.
+func (g *Game) PlayQueue(items []jellyfin.MediaItem) {
+	if len(items) == 0 {
+		return
+	}
+	entries := make([]player.QueueItem, len(items))
+	for i, item := range items {
+		entries[i] = player.QueueItem{ID: item.ID, Name: item.Name}
+	}
+	g.queue = player.NewQueue(entries)
+	first := items[0]
+	g.StartPlayback(first.ID, 0, &first)
+}
+
 // PlayURL plays an arbitrary URL (e.g. YouTube trailer) via mpv without Jellyfin progress reporting.
-func (g *Game) PlayURL(url string) {
-	if g.Player == nil {
-		if err := g.InitPlayer(); err != nil {
-			log.Printf("Failed to init player: %v", err)
+// Trailers play at playback.trailer_volume. With playback.resume_urls set,
+// a URL stopped part way through offers to resume.
+func (g *Game) PlayURL(url string, trailer bool) {
+	if g.Config.Playback.ResumeURLs && g.urlResume != nil {
+		if pos := g.urlResume.Position(url); pos > 0 {
+			g.Screens.ShowConfirm(&ui.ConfirmDialog{
+				Title:        ui.T("confirm.resume_title"),
+				Message:      ui.Tf("confirm.resume_message", player.FormatDuration(pos)),
+				ConfirmLabel: ui.T("confirm.resume"),
+				CancelLabel:  ui.T("confirm.start_over"),
+				OnConfirm:    func() { g.playURL(url, trailer, pos) },
+				OnCancel:     func() { g.playURL(url, trailer, 0) },
+			})
 			return
 		}
 	}
+	g.playURL(url, trailer, 0)
+}
 
-	wid, err := player.GetWindowHandle()
-	if err != nil {
-		log.Printf("Failed to get window handle: %v", err)
+// playURL plays url from startSec seconds.
+func (g *Game) playURL(url string, trailer bool, startSec float64) {
+	if !g.preparePlayer() {
 		return
 	}
-	if err := g.Player.SetWindowID(wid); err != nil {
-		log.Printf("Failed to set window ID: %v", err)
+	g.restoreVolume()
+	if vol := g.Config.Playback.TrailerVolume; trailer && vol > 0 {
+		if cur, ok := g.Player.Volume(); ok {
+			g.normalVolume, g.trailerVolumeOn = cur, true
+			g.Player.SetVolume(vol)
+		}
 	}
 
-	if err := g.Player.LoadFile(url, "", 0); err != nil {
-		log.Printf("Failed to load URL: %v", err)
+	if err := g.Player.LoadFile(url, "", startSec); err != nil {
+		g.playbackFailed("Playback failed", "mpv could not open this video.", err)
 		return
 	}
+	g.queue = nil
+	g.playingURL = url
 
 	g.currentItem = nil
 	g.nextEpCh = make(chan *jellyfin.MediaItem, 1)
 
-	g.overlay = player.NewPlaybackOverlay(g.Player, g.Width, g.Height)
+	g.overlay = player.NewPlaybackOverlay(g.Player, g.Width, g.Height, g.Config.Playback)
+	g.overlay.FormatClock = ui.FormatClock
 	g.overlay.OnStop = func() { g.StopPlayback() }
 	g.overlay.Show()
 
+	g.sleepInhibit.Start()
 	g.State = StatePlay
 	g.playbackEnded = false
 }
@@ -162,11 +465,25 @@ func (g *Game) StopPlayback() {
 		g.overlay.Hide()
 		g.overlay = nil
 	}
+	// Trailers (no item) keep their volume to themselves
+	if g.Player != nil && g.Player.ItemID() != "" {
+		g.rememberVolume()
+	}
 	if g.Player != nil && g.Player.Playing() {
 		itemID := g.Player.ItemID()
-		posTicks := int64(g.Player.Position() * constants.TicksPerSecond)
+		pos, dur := g.Player.Position(), g.Player.Duration()
+		posTicks := int64(pos * constants.TicksPerSecond)
+		if itemID == "" {
+			g.saveURLPosition(pos, dur)
+		}
 		g.Player.Stop()
-		if itemID != "" {
+		if itemID != "" && g.pastPlayedThreshold(pos, dur) {
+			// Close enough to the end — mark played and drop the resume point
+			go func() {
+				g.Client.ReportPlaybackStopped(itemID, 0)
+				g.Client.MarkPlayed(itemID)
+			}()
+		} else if itemID != "" {
 			go g.Client.ReportPlaybackStopped(itemID, posTicks)
 		}
 	}
@@ -183,17 +500,116 @@ func (g *Game) StopPlayback() {
 	}
 	g.nextEpItem = nil
 	g.currentItem = nil
+	g.restoreVolume()
+	g.autoPaused = false
+	g.playingURL = ""
+	g.sleepInhibit.Stop()
 	g.State = StateBrowse
 }
 
-// prefetchNextEpisode looks up the next episode and pre-fetches its metadata
-// and thumbnail for the overlay tooltip. Runs as a goroutine.
-func (g *Game) prefetchNextEpisode(item *jellyfin.MediaItem) {
-	if item == nil || item.Type != "Episode" || item.SeriesID == "" {
+// urlResumeMin is how far into a URL playback has to get before its
+// position is kept.
+const urlResumeMin = 30.0
+
+// saveURLPosition keeps where the current PlayURL playback stopped, or
+// forgets it when barely started or watched to the end.
+func (g *Game) saveURLPosition(pos, dur float64) {
+	if !g.Config.Playback.ResumeURLs || g.urlResume == nil || g.playingURL == "" {
 		return
 	}
+	if pos < urlResumeMin || g.pastPlayedThreshold(pos, dur) {
+		pos = 0
+	}
+	if err := g.urlResume.Set(g.playingURL, pos); err != nil {
+		log.Printf("Failed to save URL resume position: %v", err)
+	}
+}
+
+// restoreVolume undoes the trailer volume, if one is set.
+func (g *Game) restoreVolume() {
+	if !g.trailerVolumeOn {
+		return
+	}
+	g.trailerVolumeOn = false
+	g.Player.SetVolume(g.normalVolume)
+}
+
+// rememberVolume saves the player's volume as playback.volume, so the next
+// session starts where this one was left (playback.remember_volume).
+func (g *Game) rememberVolume() {
+	if !g.Config.Playback.RememberVolume {
+		return
+	}
+	vol, ok := g.Player.Volume()
+	if !ok || vol == g.Config.Playback.Volume {
+		return
+	}
+	g.Config.Playback.Volume = vol
+	if err := g.Config.Save(); err != nil {
+		log.Printf("Failed to save volume: %v", err)
+	}
+}
+
+// pauseOnFocusLoss pauses playback when the window loses focus and resumes
+// it on return, unless the user paused it themselves
+// (playback.pause_on_focus_loss).
+func (g *Game) pauseOnFocusLoss() {
+	if !g.Config.Playback.PauseOnFocusLoss || g.Player == nil {
+		return
+	}
+	focused := ebiten.IsFocused()
+	switch {
+	case !focused && !g.autoPaused && !g.Player.Paused():
+		g.Player.TogglePause()
+		g.autoPaused = true
+	case focused && g.autoPaused:
+		g.autoPaused = false
+		if g.Player.Paused() {
+			g.Player.TogglePause()
+		}
+	}
+}
 
-	next := g.lookupNextEpisode(item)
+// progressReportInterval is how often playback progress is reported to the server.
+const progressReportInterval = 10 * time.Second
+
+// reportProgress sends a periodic progress heartbeat and marks the item played
+// once it passes the configured threshold. Called every frame in play mode.
+func (g *Game) reportProgress() {
+	if g.Player == nil || time.Since(g.lastProgressReport) < progressReportInterval {
+		return
+	}
+	g.lastProgressReport = time.Now()
+
+	itemID := g.Player.ItemID()
+	if itemID == "" || !g.Player.Playing() {
+		return
+	}
+	pos, dur := g.Player.Position(), g.Player.Duration()
+	go g.Client.ReportPlaybackProgress(itemID, int64(pos*constants.TicksPerSecond), g.Player.Paused())
+
+	if !g.markedPlayed && g.pastPlayedThreshold(pos, dur) {
+		g.markedPlayed = true
+		go g.Client.MarkPlayed(itemID)
+	}
+}
+
+// pastPlayedThreshold reports whether pos is at or beyond the configured
+// played percentage of dur. A threshold of 0 disables auto-marking.
+func (g *Game) pastPlayedThreshold(pos, dur float64) bool {
+	pct := g.Config.Playback.PlayedThreshold
+	return pct > 0 && dur > 0 && pos >= dur*float64(pct)/100
+}
+
+// prefetchNextEpisode looks up the next episode (or the next queue entry when
+// q is set) and pre-fetches its metadata and thumbnail for the overlay tooltip.
+// Runs as a goroutine.
+func (g *Game) prefetchNextEpisode(item *jellyfin.MediaItem, q *player.Queue) {
+	if q == nil && (item == nil || item.Type != "Episode" || item.SeriesID == "") {
+		return
+	}
+
+	next := g.lookupNext(item, q)
 	if next == nil {
 		if g.overlay != nil {
 			g.overlay.SetNoNextEpisode()
@@ -268,12 +684,13 @@ func (g *Game) playNextEpisode() {
 // findAndQueueNextEpisode looks up the next episode and sends it on nextEpCh.
 func (g *Game) findAndQueueNextEpisode() {
 	item := g.currentItem
-	if item == nil || item.Type != "Episode" || item.SeriesID == "" {
+	q := g.queue
+	if q == nil && (item == nil || item.Type != "Episode" || item.SeriesID == "") {
 		return
 	}
 	ch := g.nextEpCh
 	go func() {
-		next := g.lookupNextEpisode(item)
+		next := g.lookupNext(item, q)
 		if next == nil {
 			ch <- nil
 			return
@@ -288,16 +705,29 @@ func (g *Game) findAndQueueNextEpisode() {
 	}()
 }
 
-// lookupNextEpisode finds the next episode after the given one.
+// lookupNext returns the entry after the current one in q, or the next
+// episode after item when no queue is playing.
+func (g *Game) lookupNext(item *jellyfin.MediaItem, q *player.Queue) *jellyfin.MediaItem {
+	if q != nil {
+		next, ok := q.Peek()
+		if !ok {
+			return nil
+		}
+		return &jellyfin.MediaItem{ID: next.ID, Name: next.Name}
+	}
+	return g.lookupNextEpisode(item)
+}
+
+// lookupNextEpisode finds the next episode after the given one. Episodes are
+// ordered by IndexNumber and specials are skipped unless configured otherwise.
+// Returns nil after the last episode of the series.
 func (g *Game) lookupNextEpisode(item *jellyfin.MediaItem) *jellyfin.MediaItem {
 	// Try next episode in the same season
 	if item.SeasonID != "" {
 		episodes, err := g.Client.GetEpisodes(item.SeriesID, item.SeasonID)
 		if err == nil {
-			for i, ep := range episodes {
-				if ep.ID == item.ID && i+1 < len(episodes) {
-					return &episodes[i+1]
-				}
+			if next := nextInSeason(episodes, item.ID); next != nil {
+				return next
 			}
 		}
 	}
@@ -307,16 +737,11 @@ func (g *Game) lookupNextEpisode(item *jellyfin.MediaItem) *jellyfin.MediaItem {
 	if err != nil {
 		return nil
 	}
-	foundSeason := false
-	for _, season := range seasons {
-		if foundSeason {
-			eps, err := g.Client.GetEpisodes(item.SeriesID, season.ID)
-			if err == nil && len(eps) > 0 {
-				return &eps[0]
-			}
-		}
-		if season.ID == item.SeasonID {
-			foundSeason = true
+	for _, season := range seasonsAfter(seasons, item.SeasonID, g.Config.Playback.IncludeSpecials) {
+		eps, err := g.Client.GetEpisodes(item.SeriesID, season.ID)
+		if err == nil && len(eps) > 0 {
+			sortByIndex(eps)
+			return &eps[0]
 		}
 	}
 	return nil
@@ -328,6 +753,28 @@ func (g *Game) Update() error {
 		g.startFullscreen = false
 		ebiten.SetFullscreen(true)
 	}
+	if g.preloadPending {
+		g.preloadPending = false
+		g.PreloadPlayer()
+	}
+	select {
+	case cmd := <-g.commands:
+		g.runCommand(cmd)
+	default:
+	}
+	for drained := false; !drained; {
+		select {
+		case fn := <-g.updates:
+			fn()
+		default:
+			drained = true
+		}
+	}
+	if g.startItem != nil {
+		item := g.startItem
+		g.startItem = nil
+		g.StartPlayback(item.ID, item.PlaybackPositionTicks, item)
+	}
 
 	// Alt+Enter toggles fullscreen (works in all modes)
 	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) && ebiten.IsKeyPressed(ebiten.KeyAlt) {
@@ -337,13 +784,44 @@ func (g *Game) Update() error {
 	// F12 toggles debug overlay (works in all modes)
 	ui.ToggleDebugOverlay()
 
+	// Take this frame's TV remote presses from the evdev readers
+	ui.PollRemote()
+
+	// Hand images decoded since the last frame to the screens waiting on them
+	g.Cache.Upload()
+
 	switch g.State {
 	case StateBrowse:
 		if err := g.Screens.Update(); err != nil {
 			return err
 		}
 
+	case StateBrowseAudio:
+		if mx, my, clicked := ui.MouseJustClicked(); clicked && g.nowPlaying.HandleClick(mx, my) {
+			break
+		}
+		if err := g.Screens.Update(); err != nil {
+			return err
+		}
+		g.updateBackgroundAudio()
+
 	case StatePlay:
+		select {
+		case reason := <-g.playbackErr:
+			if g.retryTranscoded() {
+				return nil
+			}
+			title := "Playback failed"
+			if g.currentItem != nil {
+				title = "Couldn't play " + g.currentItem.Name
+			}
+			g.queue = nil
+			g.StopPlayback()
+			g.Screens.ShowMessage(title, reason, "")
+			return nil
+		default:
+		}
+
 		if g.playbackEnded {
 			g.playbackEnded = false
 			if g.nextEpItem != nil {
@@ -352,6 +830,16 @@ func (g *Game) Update() error {
 				g.StartPlayback(next.ID, 0, next)
 				return nil
 			}
+			g.queue = nil
+			g.saveURLPosition(0, 0) // played to the end
+			// Keep the volume as StopPlayback would; trailers keep theirs
+			// to themselves
+			if g.Player.ItemID() != "" {
+				g.rememberVolume()
+			}
+			g.restoreVolume()
+			g.playingURL = ""
+			g.sleepInhibit.Stop()
 			g.State = StateBrowse
 			return nil
 		}
@@ -361,29 +849,20 @@ func (g *Game) Update() error {
 			g.overlay.Update()
 		}
 
+		g.reportProgress()
+		g.pauseOnFocusLoss()
+
 		// Check for pre-fetched next-episode result
-		if g.nextEpCh != nil {
-			select {
-			case nextItem := <-g.nextEpCh:
-				g.nextEpCh = nil
-				if nextItem != nil {
-					if g.nextEpItem != nil {
-						// Already have a stored result — this shouldn't happen, ignore
-					} else {
-						g.nextEpItem = nextItem
-						if g.overlay != nil {
-							g.overlay.SetNextUp(nextItem.Name, nextItem.IndexNumber)
-						}
-					}
-				} else if g.Player != nil {
-					g.Player.ShowText("No next episode", 3000)
-				}
-			default:
-			}
+		g.receiveNextEpisode()
+
+		// "Go to time" prompt captures all input (including Backspace/Esc)
+		if g.timecode.active {
+			g.handleTimecodeInput()
+			return nil
 		}
 
 		// Esc/Back — context-dependent behavior
-		backPressed := inpututil.IsKeyJustPressed(ebiten.KeyEscape) ||
+		backPressed := ui.KeyJustPressed(ebiten.KeyEscape) ||
 			inpututil.IsKeyJustPressed(ebiten.KeyBackspace) ||
 			inpututil.IsMouseButtonJustPressed(ebiten.MouseButton3) ||
 			ui.EvdevBackJustPressed()
@@ -393,6 +872,9 @@ func (g *Game) Update() error {
 			case player.OverlayTrackSelect:
 				g.overlay.HandleTrackInput(player.DirNone, false, true)
 				return nil
+			case player.OverlayBookmarks:
+				g.overlay.HandleBookmarkInput(player.DirNone, false, true, false)
+				return nil
 			case player.OverlayBar:
 				g.overlay.Hide()
 				return nil
@@ -403,10 +885,16 @@ func (g *Game) Update() error {
 			}
 		}
 
-		if backPressed {
+		if backPressed && g.currentItem != nil && isAudio(g.currentItem) {
+			g.enterBackgroundAudio()
+			return nil
+		}
+		if backPressed || ui.RemoteJustPressed(ui.RemoteStop) {
+			g.queue = nil
 			g.StopPlayback()
 			return nil
 		}
+		g.handleRemoteMediaKeys()
 
 		// Forward playback controls to mpv (required on Windows where
 		// embedded mpv doesn't receive keyboard input directly)
@@ -419,10 +907,13 @@ func (g *Game) Update() error {
 
 func (g *Game) Draw(screen *ebiten.Image) {
 	switch g.State {
-	case StateBrowse:
+	case StateBrowse, StateBrowseAudio:
 		screen.Fill(ui.ColorBackground)
 		g.Screens.Draw(screen)
-		ui.DrawDebugOverlay(screen)
+		if g.State == StateBrowseAudio {
+			g.drawNowPlaying(screen)
+		}
+		ui.DrawDebugOverlay(screen, g.Cache)
 
 	case StatePlay:
 		// In play mode, mpv owns the window surface via --wid.
@@ -453,8 +944,16 @@ func (g *Game) handlePlaybackInput() {
 		dir = player.DirUp
 	} else if keyJustPressed(kb.SeekBackwardLarge) {
 		dir = player.DirDown
+	} else if ui.RemoteJustPressed(ui.RemoteRight) {
+		dir = player.DirRight
+	} else if ui.RemoteJustPressed(ui.RemoteLeft) {
+		dir = player.DirLeft
+	} else if ui.RemoteJustPressed(ui.RemoteUp) {
+		dir = player.DirUp
+	} else if ui.RemoteJustPressed(ui.RemoteDown) {
+		dir = player.DirDown
 	}
-	enterPressed := inpututil.IsKeyJustPressed(ebiten.KeyEnter) && !ui.IsModifierPressed()
+	enterPressed := ui.KeyJustPressed(ebiten.KeyEnter) && !ui.IsModifierPressed()
 
 	if g.overlay == nil {
 		return
@@ -463,6 +962,8 @@ func (g *Game) handlePlaybackInput() {
 	switch g.overlay.Mode {
 	case player.OverlayTrackSelect:
 		g.handleInputTrackSelect(dir, enterPressed)
+	case player.OverlayBookmarks:
+		g.handleInputBookmarks(dir, enterPressed, kb)
 	case player.OverlayNextUp:
 		g.handleInputNextUp(dir, enterPressed)
 	case player.OverlayBar:
@@ -477,6 +978,17 @@ func (g *Game) handleInputTrackSelect(dir player.Direction, enter bool) {
 	g.overlay.HandleTrackInput(dir, enter, false)
 }
 
+// handleInputBookmarks handles input when the bookmark panel is open.
+// The add-bookmark key still works so bookmarks can be dropped from the list.
+func (g *Game) handleInputBookmarks(dir player.Direction, enter bool, kb *config.KeybindConfig) {
+	del := inpututil.IsKeyJustPressed(ebiten.KeyDelete) || inpututil.IsKeyJustPressed(ebiten.KeyX)
+	if keyJustPressed(kb.AddBookmark) {
+		g.overlay.AddBookmark()
+		return
+	}
+	g.overlay.HandleBookmarkInput(dir, enter, false, del)
+}
+
 // handleInputNextUp handles input when the "Up Next" banner is showing.
 func (g *Game) handleInputNextUp(dir player.Direction, enter bool) {
 	if enter && g.overlay.OnStartNextUp != nil {
@@ -506,20 +1018,20 @@ func (g *Game) handleInputHidden(enter bool, kb *config.KeybindConfig) {
 		g.Player.TogglePause()
 		g.overlay.Show()
 	}
-	if keyJustPressed(kb.SeekForward) {
-		g.Player.Seek(player.SeekSmall)
+	if keyJustPressed(kb.SeekForward) || ui.RemoteJustPressed(ui.RemoteRight) {
+		g.Player.Seek(g.Player.Seeks.Small)
 		g.Player.ShowProgress()
 	}
-	if keyJustPressed(kb.SeekBackward) {
-		g.Player.Seek(-player.SeekSmall)
+	if keyJustPressed(kb.SeekBackward) || ui.RemoteJustPressed(ui.RemoteLeft) {
+		g.Player.Seek(-g.Player.Seeks.Small)
 		g.Player.ShowProgress()
 	}
-	if keyJustPressed(kb.SeekForwardLarge) {
-		g.Player.Seek(player.SeekLarge)
+	if keyJustPressed(kb.SeekForwardLarge) || ui.RemoteJustPressed(ui.RemoteUp) {
+		g.Player.Seek(g.Player.Seeks.Large)
 		g.Player.ShowProgress()
 	}
-	if keyJustPressed(kb.SeekBackwardLarge) {
-		g.Player.Seek(-player.SeekLarge)
+	if keyJustPressed(kb.SeekBackwardLarge) || ui.RemoteJustPressed(ui.RemoteDown) {
+		g.Player.Seek(-g.Player.Seeks.Large)
 		g.Player.ShowProgress()
 	}
 	g.handleCommonPlaybackKeys(kb, false)
@@ -532,6 +1044,34 @@ func (g *Game) handleInputHidden(enter bool, kb *config.KeybindConfig) {
 	g.handlePlaybackMouse()
 }
 
+// handleRemoteMediaKeys handles a TV remote's transport buttons, which work
+// the same whatever the overlay shows. Stop is handled with Back.
+func (g *Game) handleRemoteMediaKeys() {
+	if g.Player == nil {
+		return
+	}
+	paused := g.Player.Paused()
+	if ui.RemoteJustPressed(ui.RemotePlayPause) ||
+		(ui.RemoteJustPressed(ui.RemotePlay) && paused) ||
+		(ui.RemoteJustPressed(ui.RemotePause) && !paused) {
+		g.Player.TogglePause()
+		if g.overlay != nil {
+			g.overlay.Show()
+		}
+	}
+	if ui.RemoteJustPressed(ui.RemoteFastForward) {
+		g.Player.Seek(g.Player.Seeks.Large)
+		g.Player.ShowProgress()
+	}
+	if ui.RemoteJustPressed(ui.RemoteRewind) {
+		g.Player.Seek(-g.Player.Seeks.Large)
+		g.Player.ShowProgress()
+	}
+	if ui.RemoteJustPressed(ui.RemoteInfo) && g.overlay != nil {
+		g.overlay.Show()
+	}
+}
+
 // handleCommonPlaybackKeys handles volume, track, and fullscreen keys shared
 // between bar-visible and hidden modes. When barVisible is true, actions
 // re-show the overlay bar; otherwise they show a brief progress indicator.
@@ -570,6 +1110,49 @@ func (g *Game) handleCommonPlaybackKeys(kb *config.KeybindConfig, barVisible boo
 	if keyJustPressed(kb.Fullscreen) {
 		ebiten.SetFullscreen(!ebiten.IsFullscreen())
 	}
+	// Frame stepping only makes sense while paused
+	if g.Player.Paused() {
+		if keyJustPressed(kb.FrameStep) {
+			g.Player.FrameStep()
+			g.Player.ShowPreciseTime()
+		}
+		if keyJustPressed(kb.FrameBackStep) {
+			g.Player.FrameBackStep()
+			g.Player.ShowPreciseTime()
+		}
+	}
+	for _, adj := range []struct {
+		key   string
+		prop  string
+		delta int
+	}{
+		{kb.ContrastDown, player.PictureContrast, -player.PictureStep},
+		{kb.ContrastUp, player.PictureContrast, player.PictureStep},
+		{kb.BrightnessDown, player.PictureBrightness, -player.PictureStep},
+		{kb.BrightnessUp, player.PictureBrightness, player.PictureStep},
+		{kb.GammaDown, player.PictureGamma, -player.PictureStep},
+		{kb.GammaUp, player.PictureGamma, player.PictureStep},
+	} {
+		if keyJustPressed(adj.key) {
+			g.Player.AdjustPicture(adj.prop, adj.delta)
+		}
+	}
+	if keyJustPressed(kb.PictureReset) {
+		g.resetPicture()
+		g.Player.ShowText("Picture reset", 1500)
+	}
+	if keyJustPressed(kb.GotoTime) {
+		g.openTimecodeEntry()
+	}
+	if keyJustPressed(kb.AddBookmark) {
+		g.overlay.AddBookmark()
+	}
+	if keyJustPressed(kb.Bookmarks) {
+		if !barVisible {
+			g.overlay.Show()
+		}
+		g.overlay.OpenBookmarkPanel()
+	}
 }
 
 // handlePlaybackMouse handles mouse input during playback (same in all overlay modes).
@@ -53,6 +53,10 @@ var keyMap = map[string]ebiten.Key{
 	"7":      ebiten.KeyDigit7,
 	"8":      ebiten.KeyDigit8,
 	"9":      ebiten.KeyDigit9,
+	".":      ebiten.KeyPeriod,
+	"period": ebiten.KeyPeriod,
+	",":      ebiten.KeyComma,
+	"comma":  ebiten.KeyComma,
 }
 
 // parseKey converts a config key name to an ebiten.Key.
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"sort"
+
+	"github.com/depeter/jellycouch/internal/jellyfin"
+)
+
+// sortByIndex orders seasons or episodes by IndexNumber. The server usually
+// returns them in order, but missing or re-numbered metadata can interleave them.
+func sortByIndex(items []jellyfin.MediaItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].IndexNumber < items[j].IndexNumber
+	})
+}
+
+// nextInSeason returns the episode after itemID in a season, or nil if itemID
+// is the last episode (or not found).
+func nextInSeason(episodes []jellyfin.MediaItem, itemID string) *jellyfin.MediaItem {
+	sortByIndex(episodes)
+	for i, ep := range episodes {
+		if ep.ID == itemID && i+1 < len(episodes) {
+			return &episodes[i+1]
+		}
+	}
+	return nil
+}
+
+// seasonsAfter returns the seasons following currentSeasonID in playback order.
+// Specials (season 0) are skipped unless includeSpecials is set.
+func seasonsAfter(seasons []jellyfin.MediaItem, currentSeasonID string, includeSpecials bool) []jellyfin.MediaItem {
+	sortByIndex(seasons)
+	var out []jellyfin.MediaItem
+	found := false
+	for _, season := range seasons {
+		if found && (includeSpecials || season.IndexNumber != 0) {
+			out = append(out, season)
+		}
+		if season.ID == currentSeasonID {
+			found = true
+		}
+	}
+	return out
+}
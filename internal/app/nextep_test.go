@@ -0,0 +1,82 @@
+package app
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/depeter/jellycouch/internal/jellyfin"
+)
+
+// items builds MediaItems from "id:index" pairs.
+func items(pairs ...any) []jellyfin.MediaItem {
+	var out []jellyfin.MediaItem
+	for i := 0; i < len(pairs); i += 2 {
+		out = append(out, jellyfin.MediaItem{ID: pairs[i].(string), IndexNumber: pairs[i+1].(int)})
+	}
+	return out
+}
+
+func ids(items []jellyfin.MediaItem) []string {
+	out := []string{}
+	for _, item := range items {
+		out = append(out, item.ID)
+	}
+	return out
+}
+
+func TestNextInSeason(t *testing.T) {
+	tests := []struct {
+		name     string
+		episodes []jellyfin.MediaItem
+		current  string
+		want     string // "" = nil
+	}{
+		{"in order", items("e1", 1, "e2", 2, "e3", 3), "e1", "e2"},
+		{"out of order", items("e3", 3, "e1", 1, "e2", 2), "e1", "e2"},
+		{"gap in numbering", items("e1", 1, "e5", 5, "e2", 2), "e2", "e5"},
+		{"last episode", items("e2", 2, "e1", 1), "e2", ""},
+		{"not found", items("e1", 1, "e2", 2), "x", ""},
+		{"empty season", nil, "e1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := nextInSeason(tt.episodes, tt.current)
+			got := ""
+			if next != nil {
+				got = next.ID
+			}
+			if got != tt.want {
+				t.Errorf("nextInSeason(%v, %q) = %q, want %q", ids(tt.episodes), tt.current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeasonsAfter(t *testing.T) {
+	tests := []struct {
+		name            string
+		seasons         []jellyfin.MediaItem
+		current         string
+		includeSpecials bool
+		want            []string
+	}{
+		{"rolls over to later seasons", items("s1", 1, "s2", 2, "s3", 3), "s1", false, []string{"s2", "s3"}},
+		{"out of order", items("s3", 3, "s1", 1, "s2", 2), "s1", false, []string{"s2", "s3"}},
+		{"last season", items("s1", 1, "s2", 2), "s2", false, []string{}},
+		{"skips specials", items("s0", 0, "s1", 1, "s2", 2), "s1", false, []string{"s2"}},
+		{"specials sort first", items("s1", 1, "s0", 0, "s2", 2), "s1", true, []string{"s2"}},
+		{"from specials", items("s0", 0, "s1", 1, "s2", 2), "s0", false, []string{"s1", "s2"}},
+		{"includes specials", items("sa", 0, "sb", 0, "s1", 1), "sa", true, []string{"sb", "s1"}},
+		{"skips other specials", items("sa", 0, "sb", 0, "s1", 1), "sa", false, []string{"s1"}},
+		{"not found", items("s1", 1, "s2", 2), "x", false, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(seasonsAfter(tt.seasons, tt.current, tt.includeSpecials))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("seasonsAfter(%v, %q, %v) = %v, want %v",
+					ids(tt.seasons), tt.current, tt.includeSpecials, got, tt.want)
+			}
+		})
+	}
+}
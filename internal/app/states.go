@@ -6,4 +6,5 @@ type AppState int
 const (
 	StateBrowse AppState = iota
 	StatePlay
+	StateBrowseAudio // browsing while audio plays on, with the now-playing bar
 )
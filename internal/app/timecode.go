@@ -0,0 +1,84 @@
+package app
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/depeter/jellycouch/internal/ui"
+)
+
+// timecodeMaxDigits is the number of digits accepted (hhmmss).
+const timecodeMaxDigits = 6
+
+// timecodeEntry collects digits for a "go to time" seek during playback.
+// Digits fill from the right like a microwave keypad: typing 1, 3, 0 gives 00:01:30.
+type timecodeEntry struct {
+	active bool
+	digits string
+}
+
+// String formats the entered digits as hh:mm:ss.
+func (tc *timecodeEntry) String() string {
+	d := fmt.Sprintf("%0*s", timecodeMaxDigits, tc.digits)
+	return d[0:2] + ":" + d[2:4] + ":" + d[4:6]
+}
+
+var digitKeys = [...][2]ebiten.Key{
+	{ebiten.KeyDigit0, ebiten.KeyNumpad0},
+	{ebiten.KeyDigit1, ebiten.KeyNumpad1},
+	{ebiten.KeyDigit2, ebiten.KeyNumpad2},
+	{ebiten.KeyDigit3, ebiten.KeyNumpad3},
+	{ebiten.KeyDigit4, ebiten.KeyNumpad4},
+	{ebiten.KeyDigit5, ebiten.KeyNumpad5},
+	{ebiten.KeyDigit6, ebiten.KeyNumpad6},
+	{ebiten.KeyDigit7, ebiten.KeyNumpad7},
+	{ebiten.KeyDigit8, ebiten.KeyNumpad8},
+	{ebiten.KeyDigit9, ebiten.KeyNumpad9},
+}
+
+// openTimecodeEntry starts a "go to time" prompt on the OSD.
+func (g *Game) openTimecodeEntry() {
+	if g.overlay != nil {
+		g.overlay.Hide()
+	}
+	g.timecode = timecodeEntry{active: true}
+	g.renderTimecodeEntry()
+}
+
+// handleTimecodeInput consumes all playback input while the prompt is open.
+// Enter seeks, Backspace deletes a digit, Esc cancels.
+func (g *Game) handleTimecodeInput() {
+	for i, keys := range digitKeys {
+		if inpututil.IsKeyJustPressed(keys[0]) || inpututil.IsKeyJustPressed(keys[1]) {
+			if len(g.timecode.digits) < timecodeMaxDigits {
+				g.timecode.digits += fmt.Sprintf("%d", i)
+				g.renderTimecodeEntry()
+			}
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.timecode.digits) > 0 {
+		g.timecode.digits = g.timecode.digits[:len(g.timecode.digits)-1]
+		g.renderTimecodeEntry()
+	}
+	if ui.KeyJustPressed(ebiten.KeyEscape) {
+		g.timecode = timecodeEntry{}
+		g.Player.ShowText("", 1)
+		return
+	}
+	if ui.KeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		tc := g.timecode.String()
+		g.timecode = timecodeEntry{}
+		if err := g.Player.SeekToTimecode(tc); err != nil {
+			log.Printf("Seek to %s failed: %v", tc, err)
+			return
+		}
+		g.Player.ShowText("Go to "+tc, 1500)
+	}
+}
+
+func (g *Game) renderTimecodeEntry() {
+	g.Player.ShowText("Go to: "+g.timecode.String()+"   (digits, Enter: seek, Esc: cancel)", 60000)
+}
@@ -0,0 +1,121 @@
+// Package blurhash decodes BlurHash strings (https://blurha.sh), the compact
+// image previews Jellyfin sends alongside image tags.
+package blurhash
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+)
+
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+var errInvalid = errors.New("blurhash: invalid hash")
+
+// Decode renders hash as a w×h image. Previews are blurry by nature, so a
+// small size scaled up when drawn looks the same as a large one.
+func Decode(hash string, w, h int) (*image.NRGBA, error) {
+	if len(hash) < 6 {
+		return nil, errInvalid
+	}
+	size, err := decode83(hash[:1])
+	if err != nil {
+		return nil, err
+	}
+	numX, numY := size%9+1, size/9+1
+	if len(hash) != 4+2*numX*numY {
+		return nil, errInvalid
+	}
+	quantMax, err := decode83(hash[1:2])
+	if err != nil {
+		return nil, err
+	}
+	maxAC := float64(quantMax+1) / 166
+
+	colors := make([][3]float64, numX*numY)
+	for i := range colors {
+		if i == 0 {
+			v, err := decode83(hash[2:6])
+			if err != nil {
+				return nil, err
+			}
+			colors[0] = [3]float64{srgbToLinear(v >> 16), srgbToLinear(v >> 8 & 0xFF), srgbToLinear(v & 0xFF)}
+			continue
+		}
+		v, err := decode83(hash[4+i*2 : 6+i*2])
+		if err != nil {
+			return nil, err
+		}
+		colors[i] = [3]float64{
+			signPow((float64(v/(19*19))-9)/9, 2) * maxAC,
+			signPow((float64(v/19%19)-9)/9, 2) * maxAC,
+			signPow((float64(v%19)-9)/9, 2) * maxAC,
+		}
+	}
+
+	// The basis cosines only depend on one axis each
+	cosX := make([]float64, w*numX)
+	for x := 0; x < w; x++ {
+		for i := 0; i < numX; i++ {
+			cosX[x*numX+i] = math.Cos(math.Pi * float64(x) * float64(i) / float64(w))
+		}
+	}
+	cosY := make([]float64, h*numY)
+	for y := 0; y < h; y++ {
+		for j := 0; j < numY; j++ {
+			cosY[y*numY+j] = math.Cos(math.Pi * float64(y) * float64(j) / float64(h))
+		}
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var r, g, b float64
+			for j := 0; j < numY; j++ {
+				for i := 0; i < numX; i++ {
+					basis := cosX[x*numX+i] * cosY[y*numY+j]
+					c := colors[i+j*numX]
+					r += c[0] * basis
+					g += c[1] * basis
+					b += c[2] * basis
+				}
+			}
+			img.SetNRGBA(x, y, color.NRGBA{R: linearToSRGB(r), G: linearToSRGB(g), B: linearToSRGB(b), A: 0xFF})
+		}
+	}
+	return img, nil
+}
+
+func decode83(s string) (int, error) {
+	v := 0
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(digits, s[i])
+		if d < 0 {
+			return 0, errInvalid
+		}
+		v = v*83 + d
+	}
+	return v, nil
+}
+
+func srgbToLinear(v int) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(f float64) uint8 {
+	f = max(0, min(1, f))
+	if f <= 0.0031308 {
+		return uint8(f*12.92*255 + 0.5)
+	}
+	return uint8((1.055*math.Pow(f, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
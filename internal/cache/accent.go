@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// AccentColor returns the accent color worked out for url's image, when
+// ExtractAccents was set while it loaded and the image has a clear one.
+func (ic *ImageCache) AccentColor(url string) (color.RGBA, bool) {
+	if v, ok := ic.accents.Load(url); ok {
+		return v.(color.RGBA), true
+	}
+	return color.RGBA{}, false
+}
+
+const (
+	accentSamples = 24 // per side of the sampling grid
+	accentHues    = 12 // hue buckets
+)
+
+// dominantColor picks the most prominent vivid hue of img from a grid of
+// samples, ignoring greys and near-black pixels. It is brightened so it
+// reads as a highlight on the dark background. ok is false for images
+// without a clear color, such as black-and-white posters.
+func dominantColor(img image.Image) (c color.RGBA, ok bool) {
+	b := img.Bounds()
+	if b.Empty() {
+		return c, false
+	}
+	type bucket struct{ weight, r, g, b float64 }
+	var buckets [accentHues]bucket
+	for sy := range accentSamples {
+		for sx := range accentSamples {
+			x := b.Min.X + (sx*2+1)*b.Dx()/(accentSamples*2)
+			y := b.Min.Y + (sy*2+1)*b.Dy()/(accentSamples*2)
+			r16, g16, b16, _ := img.At(x, y).RGBA()
+			r, g, bl := float64(r16)/0xffff, float64(g16)/0xffff, float64(b16)/0xffff
+			hi, lo := max(r, g, bl), min(r, g, bl)
+			if hi < 0.2 || hi-lo < 0.15 {
+				continue // too dark or too grey to count
+			}
+			sat := (hi - lo) / hi
+			bk := &buckets[hueBucket(r, g, bl, hi, lo)]
+			w := sat * hi
+			bk.weight += w
+			bk.r += r * w
+			bk.g += g * w
+			bk.b += bl * w
+		}
+	}
+
+	best := 0
+	for i := range buckets {
+		if buckets[i].weight > buckets[best].weight {
+			best = i
+		}
+	}
+	bk := buckets[best]
+	if bk.weight < accentSamples*accentSamples*0.02 {
+		return c, false
+	}
+	r, g, bl := bk.r/bk.weight, bk.g/bk.weight, bk.b/bk.weight
+
+	// Brighten so the strongest channel is near full
+	scale := 0.9 / max(r, g, bl)
+	return color.RGBA{
+		R: uint8(math.Min(r*scale, 1) * 255),
+		G: uint8(math.Min(g*scale, 1) * 255),
+		B: uint8(math.Min(bl*scale, 1) * 255),
+		A: 0xff,
+	}, true
+}
+
+// hueBucket returns which of the accentHues buckets the hue of r, g, b
+// (with hi and lo its largest and smallest channel) falls in.
+func hueBucket(r, g, b, hi, lo float64) int {
+	d := hi - lo
+	var h float64 // 0–6
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d+6, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return min(int(h/6*accentHues), accentHues-1)
+}
@@ -1,61 +1,120 @@
 package cache
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"image"
 	_ "image/jpeg"
 	_ "image/png"
 	"io"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	_ "golang.org/x/image/webp"
+
+	"github.com/depeter/jellycouch/internal/netlog"
 )
 
-var httpClient = &http.Client{Timeout: 10 * time.Second}
+var httpClient = &http.Client{Timeout: 10 * time.Second, Transport: netlog.NewTransport("image")}
 
 // ImageCache provides disk + memory caching for images.
+//
+// Threading: images are read and decoded on background goroutines, at most
+// a few at a time for each. The decoded images are turned into ebiten images
+// on the game thread by Upload, a few per frame, which is also where
+// LoadAsync callbacks run.
 type ImageCache struct {
-	cacheDir string
-	memory   sync.Map // url -> *ebiten.Image
-	loading  sync.Map // url -> *loadEntry (in-flight dedup with waiters)
-	sem      chan struct{}
+	cacheDir  string
+	memory    sync.Map      // url -> *ebiten.Image
+	loading   sync.Map      // url -> *loadEntry (in-flight dedup with waiters)
+	sem       chan struct{} // disk reads and downloads
+	decodeSem chan struct{}
+
+	uploadMu sync.Mutex
+	uploads  []pendingUpload // decoded, waiting for Upload
+
+	memHits, diskHits, downloads, failures atomic.Int64
+
+	// ExtractAccents works out each image's accent color as it is decoded,
+	// for AccentColor. It costs a little time per image; set it before
+	// loading anything.
+	ExtractAccents bool
+	accents        sync.Map // url -> color.RGBA
+}
+
+// Stats counts image lookups since startup, for the debug overlay.
+type Stats struct {
+	MemoryHits int64 // served from memory
+	DiskHits   int64 // decoded from the disk cache
+	Downloads  int64 // fetched over the network
+	Failures   int64 // download or decode failed
+}
+
+// Stats returns the lookup counters.
+func (ic *ImageCache) Stats() Stats {
+	return Stats{
+		MemoryHits: ic.memHits.Load(),
+		DiskHits:   ic.diskHits.Load(),
+		Downloads:  ic.downloads.Load(),
+		Failures:   ic.failures.Load(),
+	}
 }
 
 // loadEntry tracks in-flight downloads and their waiters.
 type loadEntry struct {
 	mu        sync.Mutex
 	callbacks []func(*ebiten.Image)
+	done      *ebiten.Image // set once uploaded; late waiters get it at once
+}
+
+// pendingUpload is a decoded image waiting to become an ebiten image.
+type pendingUpload struct {
+	url   string
+	img   image.Image
+	entry *loadEntry
 }
 
+// uploadBudget is roughly how long Upload may spend per frame.
+const uploadBudget = 4 * time.Millisecond
+
 // NewImageCache creates a new image cache with the given disk directory.
 func NewImageCache(cacheDir string) (*ImageCache, error) {
 	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
 		return nil, err
 	}
 	return &ImageCache{
-		cacheDir: cacheDir,
-		sem:      make(chan struct{}, 6),
+		cacheDir:  cacheDir,
+		sem:       make(chan struct{}, 6),
+		decodeSem: make(chan struct{}, max(1, runtime.NumCPU()-1)),
 	}, nil
 }
 
 // Get returns a cached image if available, or nil.
 func (ic *ImageCache) Get(url string) *ebiten.Image {
 	if v, ok := ic.memory.Load(url); ok {
+		ic.memHits.Add(1)
 		return v.(*ebiten.Image)
 	}
 	return nil
 }
 
-// LoadAsync starts loading an image from URL in the background.
-// The callback is called with the image when ready (may be called from a goroutine).
+// LoadAsync starts loading an image from URL in the background. The callback
+// is called with the image when ready: at once if it is in memory, otherwise
+// on the game thread from Upload.
 func (ic *ImageCache) LoadAsync(url string, callback func(*ebiten.Image)) {
 	// Already in memory?
 	if v, ok := ic.memory.Load(url); ok {
+		ic.memHits.Add(1)
 		callback(v.(*ebiten.Image))
 		return
 	}
@@ -68,82 +127,143 @@ func (ic *ImageCache) LoadAsync(url string, callback func(*ebiten.Image)) {
 		// Another goroutine is already downloading this URL — append our callback
 		existingEntry := existing.(*loadEntry)
 		existingEntry.mu.Lock()
+		if img := existingEntry.done; img != nil {
+			existingEntry.mu.Unlock()
+			callback(img)
+			return
+		}
 		existingEntry.callbacks = append(existingEntry.callbacks, callback)
 		existingEntry.mu.Unlock()
 		return
 	}
 
 	go func() {
-		defer ic.loading.Delete(url)
-
-		// Acquire semaphore to limit concurrent downloads
-		ic.sem <- struct{}{}
-		defer func() { <-ic.sem }()
-
 		img, err := ic.loadImage(url)
 		if err != nil {
+			ic.loading.Delete(url)
+			ic.failures.Add(1)
 			return
 		}
+		if ic.ExtractAccents {
+			if c, ok := dominantColor(img); ok {
+				ic.accents.Store(url, c)
+			}
+		}
 
-		eimg := ebiten.NewImageFromImage(img)
-		ic.memory.Store(url, eimg)
+		ic.uploadMu.Lock()
+		ic.uploads = append(ic.uploads, pendingUpload{url: url, img: img, entry: entry})
+		ic.uploadMu.Unlock()
+	}()
+}
 
-		// Notify all waiters
-		entry.mu.Lock()
-		cbs := make([]func(*ebiten.Image), len(entry.callbacks))
-		copy(cbs, entry.callbacks)
-		entry.mu.Unlock()
+// Upload turns decoded images into ebiten images and calls their LoadAsync
+// callbacks, stopping once it has used its per-frame budget so a burst of
+// posters is spread over several frames. Call it once per frame from the
+// game's Update, outside any screen lock.
+func (ic *ImageCache) Upload() {
+	start := time.Now()
+	for time.Since(start) < uploadBudget {
+		ic.uploadMu.Lock()
+		if len(ic.uploads) == 0 {
+			ic.uploadMu.Unlock()
+			return
+		}
+		u := ic.uploads[0]
+		ic.uploads[0] = pendingUpload{}
+		ic.uploads = ic.uploads[1:]
+		ic.uploadMu.Unlock()
+
+		eimg := ebiten.NewImageFromImage(u.img)
+		ic.memory.Store(u.url, eimg)
+
+		u.entry.mu.Lock()
+		u.entry.done = eimg
+		cbs := u.entry.callbacks
+		u.entry.callbacks = nil
+		u.entry.mu.Unlock()
+		ic.loading.Delete(u.url)
 
 		for _, cb := range cbs {
 			cb(eimg)
 		}
-	}()
+	}
 }
 
+// loadImage reads url from the disk cache, or downloads it, and decodes it.
 func (ic *ImageCache) loadImage(url string) (image.Image, error) {
 	diskPath := ic.diskPath(url)
 
 	// Try disk cache first
-	if f, err := os.Open(diskPath); err == nil {
-		defer f.Close()
-		img, _, err := image.Decode(f)
-		if err == nil {
+	if data, err := ic.readDisk(diskPath); err == nil {
+		if img, err := ic.decode(data); err == nil {
+			ic.diskHits.Add(1)
 			return img, nil
 		}
 		// Corrupt cache file, remove and re-download
 		os.Remove(diskPath)
 	}
 
-	// Download with timeout-aware client
-	resp, err := httpClient.Get(url)
+	ic.downloads.Add(1)
+	data, err := ic.download(url)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("image download failed: %s", resp.Status)
+	img, err := ic.decode(data)
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			log.Printf("Image %s: unsupported format %s", stripQuery(url), http.DetectContentType(data))
+		}
+		return nil, err
 	}
 
-	// Save to disk
+	// Save to disk once it is known to decode
 	if err := os.MkdirAll(filepath.Dir(diskPath), 0o755); err != nil {
 		return nil, err
 	}
-	f, err := os.Create(diskPath)
-	if err != nil {
+	if err := os.WriteFile(diskPath, data, 0o644); err != nil {
+		os.Remove(diskPath)
 		return nil, err
 	}
+	return img, nil
+}
+
+// stripQuery drops the query from url for logging.
+func stripQuery(url string) string {
+	if i := strings.IndexByte(url, '?'); i >= 0 {
+		return url[:i]
+	}
+	return url
+}
+
+func (ic *ImageCache) readDisk(path string) ([]byte, error) {
+	ic.sem <- struct{}{}
+	defer func() { <-ic.sem }()
+	return os.ReadFile(path)
+}
+
+// download fetches url with the timeout-aware client.
+func (ic *ImageCache) download(url string) ([]byte, error) {
+	ic.sem <- struct{}{}
+	defer func() { <-ic.sem }()
 
-	// Tee to disk while decoding
-	tee := io.TeeReader(resp.Body, f)
-	img, _, err := image.Decode(tee)
-	f.Close()
+	resp, err := httpClient.Get(url)
 	if err != nil {
-		os.Remove(diskPath)
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	return img, nil
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image download failed: %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// decode decodes an image on one of the decode workers.
+func (ic *ImageCache) decode(data []byte) (image.Image, error) {
+	ic.decodeSem <- struct{}{}
+	defer func() { <-ic.decodeSem }()
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
 }
 
 func (ic *ImageCache) diskPath(url string) string {
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/BurntSushi/toml"
 )
@@ -14,11 +18,59 @@ type Config struct {
 	Playback   PlaybackConfig   `toml:"playback"`
 	UI         UIConfig         `toml:"ui"`
 	Keybinds   KeybindConfig    `toml:"keybinds"`
+	Input      InputConfig      `toml:"input"`
+	Cache      CacheConfig      `toml:"cache"`
+	Log        LogConfig        `toml:"log"`
 }
 
 type JellyseerrConfig struct {
-	URL    string `toml:"url"`
-	APIKey string `toml:"api_key"`
+	URL           string `toml:"url"`
+	APIKey        string `toml:"api_key"`
+	HideAvailable bool   `toml:"hide_available"` // hide discover results already in the library
+
+	// Last-used request options, preselected on the next request.
+	// LastServer is keyed by "radarr", "radarr4k", "sonarr" or "sonarr4k";
+	// ServerDefaults by "radarr:<server id>" or "sonarr:<server id>".
+	LastServer     map[string]int             `toml:"last_server,omitempty"`
+	ServerDefaults map[string]RequestDefaults `toml:"server_defaults,omitempty"`
+}
+
+// RequestDefaults are the options last used with one Radarr/Sonarr server.
+type RequestDefaults struct {
+	ProfileID         int    `toml:"profile_id"`
+	RootFolder        string `toml:"root_folder"`
+	LanguageProfileID int    `toml:"language_profile_id,omitempty"`
+}
+
+func lastServerKey(service string, is4K bool) string {
+	if is4K {
+		return service + "4k"
+	}
+	return service
+}
+
+// LastRequestServer returns the server ID last used for service ("radarr" or "sonarr").
+func (jc *JellyseerrConfig) LastRequestServer(service string, is4K bool) (int, bool) {
+	id, ok := jc.LastServer[lastServerKey(service, is4K)]
+	return id, ok
+}
+
+// RequestDefaultsFor returns the options last used with a server.
+func (jc *JellyseerrConfig) RequestDefaultsFor(service string, serverID int) (RequestDefaults, bool) {
+	d, ok := jc.ServerDefaults[fmt.Sprintf("%s:%d", service, serverID)]
+	return d, ok
+}
+
+// RememberRequest records the server and options used for a request.
+func (jc *JellyseerrConfig) RememberRequest(service string, is4K bool, serverID int, d RequestDefaults) {
+	if jc.LastServer == nil {
+		jc.LastServer = make(map[string]int)
+	}
+	if jc.ServerDefaults == nil {
+		jc.ServerDefaults = make(map[string]RequestDefaults)
+	}
+	jc.LastServer[lastServerKey(service, is4K)] = serverID
+	jc.ServerDefaults[fmt.Sprintf("%s:%d", service, serverID)] = d
 }
 
 type ServerConfig struct {
@@ -26,6 +78,60 @@ type ServerConfig struct {
 	Username string `toml:"username"`
 	Token    string `toml:"token"`
 	UserID   string `toml:"user_id"`
+
+	// MaxRequests caps concurrent requests to the server, metadata and
+	// images together; lower it for a slow server. 0 means no cap.
+	MaxRequests int `toml:"max_requests"`
+
+	// CAFile is a PEM file of CA certificates to trust for a server with a
+	// self-signed or private CA certificate, besides the system ones.
+	// InsecureSkipVerify accepts any certificate; only for testing.
+	CAFile             string `toml:"ca_file,omitempty"`
+	InsecureSkipVerify bool   `toml:"insecure_skip_verify,omitempty"`
+
+	// DeviceName is how this install appears among the server's devices;
+	// empty uses the hostname. DeviceID identifies it to the server and is
+	// generated on first start.
+	DeviceName string `toml:"device_name"`
+	DeviceID   string `toml:"device_id"`
+}
+
+// LegacyDeviceID is the device ID every install reported before each got its
+// own. A sign-in made with it keeps it until the next login.
+const LegacyDeviceID = "jellycouch-1"
+
+// EnsureDeviceID gives the install a device ID if it has none and reports
+// whether it did, so the config can be saved. An existing sign-in keeps
+// LegacyDeviceID, which its token was issued to.
+func (s *ServerConfig) EnsureDeviceID() bool {
+	if s.DeviceID != "" {
+		return false
+	}
+	if s.Token != "" {
+		s.DeviceID = LegacyDeviceID
+	} else {
+		s.DeviceID = NewDeviceID()
+	}
+	return true
+}
+
+// NewDeviceID returns a random device ID.
+func NewDeviceID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Device returns the device name to report: DeviceName, or the hostname if
+// that is empty.
+func (s *ServerConfig) Device() string {
+	if s.DeviceName != "" {
+		return s.DeviceName
+	}
+	if host, err := os.Hostname(); err == nil && host != "" {
+		return host
+	}
+	return "JellyCouch Desktop"
 }
 
 type SubtitleConfig struct {
@@ -41,16 +147,116 @@ type SubtitleConfig struct {
 }
 
 type PlaybackConfig struct {
-	HWAccel       string `toml:"hwdec"`
-	AudioLanguage string `toml:"audio_language"`
-	SubLanguage   string `toml:"sub_language"`
-	Volume        int    `toml:"volume"`
+	HWAccel          string `toml:"hwdec"`
+	AudioLanguage    string `toml:"audio_language"`
+	SubLanguage      string `toml:"sub_language"`
+	Volume           int    `toml:"volume"`
+	RememberVolume   bool   `toml:"remember_volume"`     // save the volume playback ended at as the new volume
+	TrailerVolume    int    `toml:"trailer_volume"`      // volume for trailers (0 = same as other playback)
+	IncludeSpecials  bool   `toml:"include_specials"`    // play specials (season 0) in next-episode order
+	PlayedThreshold  int    `toml:"played_threshold"`    // percent watched at which an item is marked played (0 = off)
+	PreloadPlayer    bool   `toml:"preload_player"`      // start mpv at launch instead of on first play
+	PauseOnFocusLoss bool   `toml:"pause_on_focus_loss"` // pause while the window is in the background
+	ResumeURLs       bool   `toml:"resume_urls"`         // offer to resume trailers and other videos played by URL
+
+	ResumeRewindSeconds float64 `toml:"resume_rewind_seconds"` // start this far before the resume point (0 = off)
+
+	// Picture adjustments each playback starts with, -100 to 100 (0 = as mastered)
+	Brightness int `toml:"brightness"`
+	Contrast   int `toml:"contrast"`
+	Gamma      int `toml:"gamma"`
+
+	OsdHideSeconds float64 `toml:"osd_hide_seconds"` // control bar auto-hide delay
+	ProgressLine   bool    `toml:"progress_line"`    // always show a thin progress line while playing
+	ShowClock      bool    `toml:"show_clock"`       // show the clock with the control bar, not just when paused
+	ShowBattery    bool    `toml:"show_battery"`     // add battery % next to the clock where available
+
+	SeekSmall float64   `toml:"seek_small"` // seconds for Left/Right and the short seek buttons
+	SeekLarge float64   `toml:"seek_large"` // seconds for Up/Down and the long seek buttons
+	SeekAccel []float64 `toml:"seek_accel"` // escalating steps when repeatedly seeking on the progress bar
+
+	OverlayButtons []string `toml:"overlay_buttons"` // control bar buttons, left to right
 }
 
 type UIConfig struct {
-	Fullscreen bool `toml:"fullscreen"`
-	Width      int  `toml:"width"`
-	Height     int  `toml:"height"`
+	Fullscreen   bool    `toml:"fullscreen"`
+	Width        int     `toml:"width"`
+	Height       int     `toml:"height"`
+	ContentScrim bool    `toml:"content_scrim"` // darken behind section titles
+	BackdropDim  float64 `toml:"backdrop_dim"`  // 0–1 strength of detail backdrop gradient
+	StartScreen  string  `toml:"start_screen"`  // "home", "library:<id>", "discovery" or "continue"
+	HitPadding   float64 `toml:"hit_padding"`   // extra pixels around click targets
+	Language     string  `toml:"language"`      // interface language code, e.g. "en", "nl"
+	Locale       string  `toml:"locale"`        // number, date and clock format, e.g. "en-GB"; "" = from language
+
+	Font          string   `toml:"font,omitempty"` // TTF/OTF replacing the built-in font
+	FallbackFonts []string `toml:"fallback_fonts"` // fonts for glyphs the main font lacks; empty = common system fonts
+
+	OnScreenKeyboard bool `toml:"on_screen_keyboard"` // D-pad keyboard for text fields until a real keyboard types
+
+	// Columns fixes the number of poster columns in library and search grids,
+	// scaling posters to fill the width. 0 fits as many default-size posters
+	// as the width allows.
+	Columns int `toml:"columns"`
+
+	CornerRadius float64 `toml:"corner_radius"` // rounding of posters in pixels; 0 = square
+	FocusStyle   string  `toml:"focus_style"`   // "border" frames the focused poster, "zoom" enlarges it
+	AnimateFocus bool    `toml:"animate_focus"` // ease the zoom in rather than jumping; off for slow devices
+
+	AmbientBackdrop bool `toml:"ambient_backdrop"` // focused item's backdrop behind the home screen
+	DimWatched      bool `toml:"dim_watched"`      // fade watched posters so unwatched ones stand out
+	AccentColors    bool `toml:"accent_colors"`    // tint focus frames and "Latest" headings with poster colors
+
+	RightClickAction string `toml:"right_click_action"` // "context-menu", "watched" (toggle) or "none"
+	ConfirmExit      bool   `toml:"confirm_exit"`       // ask before Back on the last screen closes the app
+
+	// LibraryStatus is the Status filter last used in each library, keyed
+	// by view ID: "" (all), "IsUnplayed", "IsPlayed", "IsFavorite" or
+	// "IsResumable". Libraries not listed start on unplayed.
+	LibraryStatus map[string]string `toml:"library_status,omitempty"`
+
+	// HiddenLatest lists the view IDs of libraries left out of the home
+	// screen's "Latest" rows, on top of those excluded on the server.
+	HiddenLatest []string `toml:"hidden_latest,omitempty"`
+}
+
+// IsLatestHidden reports whether a library's "Latest" row is hidden.
+func (u *UIConfig) IsLatestHidden(viewID string) bool {
+	return slices.Contains(u.HiddenLatest, viewID)
+}
+
+// SetLatestHidden hides or shows a library's "Latest" row.
+func (u *UIConfig) SetLatestHidden(viewID string, hidden bool) {
+	u.HiddenLatest = slices.DeleteFunc(u.HiddenLatest, func(id string) bool { return id == viewID })
+	if hidden {
+		u.HiddenLatest = append(u.HiddenLatest, viewID)
+	}
+}
+
+// RememberLibraryStatus records the Status filter used in a library.
+func (u *UIConfig) RememberLibraryStatus(viewID, status string) {
+	if u.LibraryStatus == nil {
+		u.LibraryStatus = make(map[string]string)
+	}
+	u.LibraryStatus[viewID] = status
+}
+
+// InputConfig selects the Linux input devices read as TV remotes (HDMI-CEC,
+// IR receivers). It has no effect on other platforms.
+type InputConfig struct {
+	RemoteDevices []string `toml:"remote_devices"` // substrings of device names, case-insensitive
+	// RemoteKeys overrides the key codes of remote buttons, e.g. ok = [352].
+	// Buttons not listed keep their default codes.
+	RemoteKeys map[string][]int `toml:"remote_keys,omitempty"`
+}
+
+type CacheConfig struct {
+	Dir string `toml:"dir"` // image cache; empty = "cache/images" in the config dir
+}
+
+type LogConfig struct {
+	Level     string `toml:"level"`       // debug, info, warn or error; structured logs only
+	MaxSizeMB int    `toml:"max_size_mb"` // rotate jellycouch.log at this size
 }
 
 type KeybindConfig struct {
@@ -65,11 +271,26 @@ type KeybindConfig struct {
 	SubCycle     string `toml:"sub_cycle"`
 	AudioCycle   string `toml:"audio_cycle"`
 	Fullscreen   string `toml:"fullscreen"`
+	FrameStep     string `toml:"frame_step"`
+	FrameBackStep string `toml:"frame_back_step"`
+	GotoTime      string `toml:"goto_time"`
+	AddBookmark   string `toml:"add_bookmark"`
+	Bookmarks     string `toml:"bookmarks"`
+
+	ContrastDown   string `toml:"contrast_down"`
+	ContrastUp     string `toml:"contrast_up"`
+	BrightnessDown string `toml:"brightness_down"`
+	BrightnessUp   string `toml:"brightness_up"`
+	GammaDown      string `toml:"gamma_down"`
+	GammaUp        string `toml:"gamma_up"`
+	PictureReset   string `toml:"picture_reset"` // back to the playback defaults
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Server: ServerConfig{},
+		Server: ServerConfig{
+			MaxRequests: 6,
+		},
 		Subtitles: SubtitleConfig{
 			Font:         "Liberation Sans",
 			FontSize:     48,
@@ -82,15 +303,43 @@ func DefaultConfig() *Config {
 			ASSOverride:  "force",
 		},
 		Playback: PlaybackConfig{
-			HWAccel:       "auto-safe",
-			AudioLanguage: "eng",
-			SubLanguage:   "eng",
-			Volume:        100,
+			HWAccel:         "auto-safe",
+			AudioLanguage:   "eng",
+			SubLanguage:     "eng",
+			Volume:          100,
+			RememberVolume:  true,
+			TrailerVolume:   80,
+			PlayedThreshold: 90,
+			PreloadPlayer:   true,
+			OsdHideSeconds:  4,
+			SeekSmall:       10,
+			SeekLarge:       60,
+			SeekAccel:       []float64{10, 30, 60, 300, 600},
+			OverlayButtons: []string{
+				"back_large", "back_small", "play_pause", "stop",
+				"forward_small", "forward_large", "subtitles", "audio", "next",
+			},
 		},
 		UI: UIConfig{
-			Fullscreen: true,
-			Width:      1920,
-			Height:     1080,
+			Fullscreen:   true,
+			Width:        1920,
+			Height:       1080,
+			ContentScrim: true,
+			BackdropDim:  0.75,
+			StartScreen:  "home",
+			HitPadding:   6,
+			Language:     "en",
+
+			OnScreenKeyboard: true,
+
+			CornerRadius: 6,
+			FocusStyle:   "border",
+			AnimateFocus: true,
+
+			AmbientBackdrop: true,
+
+			RightClickAction: "context-menu",
+			ConfirmExit:      true,
 		},
 		Keybinds: KeybindConfig{
 			PlayPause:         "Space",
@@ -104,11 +353,64 @@ func DefaultConfig() *Config {
 			SubCycle:          "S",
 			AudioCycle:        "A",
 			Fullscreen:        "F",
+			FrameStep:         ".",
+			FrameBackStep:     ",",
+			GotoTime:          "G",
+			AddBookmark:       "B",
+			Bookmarks:         "K",
+			ContrastDown:      "1",
+			ContrastUp:        "2",
+			BrightnessDown:    "3",
+			BrightnessUp:      "4",
+			GammaDown:         "5",
+			GammaUp:           "6",
+			PictureReset:      "7",
+		},
+		Input: InputConfig{
+			RemoteDevices: []string{"cec", "vc4-hdmi"},
 		},
+		Log: LogConfig{
+			Level:     "info",
+			MaxSizeMB: 5,
+		},
+	}
+}
+
+// configDirOverride replaces the usual config directory when set by
+// SetConfigDir (--config-dir, --portable).
+var configDirOverride string
+
+// SetConfigDir keeps config, logs and the cache in dir instead of the user's
+// config directory, creating it if needed. Call before Load.
+func SetConfigDir(dir string) error {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
 	}
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return err
+	}
+	configDirOverride = abs
+	return nil
+}
+
+// PortableDir returns the directory portable mode uses: jellycouch-data next
+// to the executable, e.g. on the same USB stick.
+func PortableDir() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	return filepath.Join(filepath.Dir(exe), "jellycouch-data"), nil
 }
 
 func ConfigDir() (string, error) {
+	if configDirOverride != "" {
+		return configDirOverride, nil
+	}
 	configHome := os.Getenv("XDG_CONFIG_HOME")
 	if configHome == "" {
 		home, err := os.UserHomeDir()
@@ -128,6 +430,61 @@ func ConfigPath() (string, error) {
 	return filepath.Join(dir, "config.toml"), nil
 }
 
+// LogPath returns the log file, rotated to jellycouch.log.1 etc. when full.
+func LogPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "jellycouch.log"), nil
+}
+
+// BookmarksPath returns the file holding per-item playback bookmarks.
+func BookmarksPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bookmarks.json"), nil
+}
+
+// ImageCacheDir returns where downloaded images are kept: cache.dir if set,
+// otherwise cache/images in the config dir. A relative cache.dir is taken
+// from the config dir, so a portable setup can move as a whole.
+func (c *Config) ImageCacheDir() (string, error) {
+	if filepath.IsAbs(c.Cache.Dir) {
+		return c.Cache.Dir, nil
+	}
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if c.Cache.Dir != "" {
+		return filepath.Join(dir, c.Cache.Dir), nil
+	}
+	return filepath.Join(dir, "cache", "images"), nil
+}
+
+// URLResumePath returns the file holding resume positions of videos played
+// by URL.
+func URLResumePath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "url_resume.json"), nil
+}
+
+// SocketPath returns the socket later launches use to reach the running
+// instance.
+func SocketPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "jellycouch.sock"), nil
+}
+
 func Load() (*Config, error) {
 	cfg := DefaultConfig()
 
@@ -5,3 +5,6 @@ const TicksPerSecond = 10_000_000
 
 // TMDBPosterW300 is the TMDB image base URL for w300 poster images.
 const TMDBPosterW300 = "https://image.tmdb.org/t/p/w300"
+
+// AppVersion is the JellyCouch version reported to Jellyfin and written to the log.
+const AppVersion = "0.1.0"
@@ -0,0 +1,97 @@
+// Package instance keeps JellyCouch to one running copy per config
+// directory. The first copy listens on a unix socket (also available on
+// Windows 10 and later); later launches hand their command line to it and
+// exit instead of opening a second window.
+package instance
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// dialTimeout is how long Forward waits for a running instance to answer.
+const dialTimeout = time.Second
+
+// Command is what a later launch asks the running instance to do.
+type Command struct {
+	Action string // "play", "item", or "" to just show the window
+	ItemID string
+}
+
+// Forward sends cmd to the instance listening on path. A nil error means a
+// running instance took the command and this process should exit.
+func Forward(path string, cmd Command) error {
+	conn, err := net.DialTimeout("unix", path, dialTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+	if _, err := fmt.Fprintf(conn, "%s %s\n", cmd.Action, cmd.ItemID); err != nil {
+		return fmt.Errorf("forward to running instance: %w", err)
+	}
+	return nil
+}
+
+// Listen makes this process the running instance, calling handle on a
+// background goroutine for every command a later launch forwards. A socket
+// left behind by a crashed instance is replaced. The returned closer stops
+// listening and removes the socket.
+func Listen(path string, handle func(Command)) (io.Closer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		// Remove the socket only if nothing answers on it: an instance
+		// started since Forward must keep its own
+		conn, dialErr := net.DialTimeout("unix", path, dialTimeout)
+		if dialErr == nil {
+			conn.Close()
+			return nil, fmt.Errorf("listen on %s: another instance is running", path)
+		}
+		os.Remove(path)
+		if ln, err = net.Listen("unix", path); err != nil {
+			return nil, fmt.Errorf("listen on %s: %w", path, err)
+		}
+	}
+	go serve(ln, handle)
+	return ln, nil
+}
+
+func serve(ln net.Listener, handle func(Command)) {
+	for {
+		conn, err := ln.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			log.Printf("Instance socket: %v", err)
+			continue
+		}
+		conn.SetDeadline(time.Now().Add(dialTimeout))
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		conn.Close()
+		if err != nil {
+			log.Printf("Instance socket: read command: %v", err)
+			continue
+		}
+		var cmd Command
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			cmd.Action = fields[0]
+		}
+		if len(fields) > 1 {
+			cmd.ItemID = fields[1]
+		}
+		handle(cmd)
+	}
+}
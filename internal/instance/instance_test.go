@@ -0,0 +1,47 @@
+package instance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestListenKeepsLiveSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jellycouch.sock")
+	got := make(chan Command, 1)
+	ln, err := Listen(path, func(cmd Command) { got <- cmd })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	if second, err := Listen(path, func(Command) {}); err == nil {
+		second.Close()
+		t.Fatal("second Listen on a live socket succeeded")
+	}
+	want := Command{Action: "play", ItemID: "abc"}
+	if err := Forward(path, want); err != nil {
+		t.Fatalf("Forward after a second Listen: %v", err)
+	}
+	select {
+	case cmd := <-got:
+		if cmd != want {
+			t.Errorf("got %+v, want %+v", cmd, want)
+		}
+	case <-time.After(time.Second):
+		t.Error("running instance never got the command")
+	}
+}
+
+func TestListenReplacesStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jellycouch.sock")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ln, err := Listen(path, func(Command) {})
+	if err != nil {
+		t.Fatalf("Listen over a stale socket: %v", err)
+	}
+	ln.Close()
+}
@@ -4,20 +4,36 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	jellyfin "github.com/sj14/jellyfin-go/api"
+
+	"github.com/depeter/jellycouch/internal/constants"
+	"github.com/depeter/jellycouch/internal/netlog"
 )
 
 const apiRequestTimeout = 15 * time.Second
 
 const (
 	clientName    = "JellyCouch"
-	clientVersion = "0.1.0"
-	deviceName    = "JellyCouch Desktop"
+	clientVersion = constants.AppVersion
+)
+
+// Device identity reported to the server, set with SetDevice
+var (
+	deviceName = "JellyCouch Desktop"
+	deviceID   = "jellycouch-1"
 )
 
+// SetDevice sets the device name and ID clients report to the server, which
+// lists sessions by them and sends remote control commands to them. It
+// affects clients created afterwards.
+func SetDevice(name, id string) {
+	deviceName, deviceID = name, id
+}
+
 // Client wraps the generated Jellyfin API client with convenience methods.
 type Client struct {
 	api       *jellyfin.APIClient
@@ -27,12 +43,25 @@ type Client struct {
 	serverURL string
 }
 
+// normalizeURL defaults the scheme to https and drops trailing slashes. A base
+// path a reverse proxy serves Jellyfin under ("https://host/jellyfin") is
+// kept, since every request and image URL is built on it. An address copied
+// from the web client ("https://host/jellyfin/web/#/home.html") is cut back
+// to that base.
 func normalizeURL(serverURL string) string {
 	serverURL = strings.TrimSpace(serverURL)
 	if !strings.HasPrefix(serverURL, "http://") && !strings.HasPrefix(serverURL, "https://") {
 		serverURL = "https://" + serverURL
 	}
-	return strings.TrimRight(serverURL, "/")
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return strings.TrimRight(serverURL, "/")
+	}
+	u.RawQuery, u.Fragment, u.RawFragment = "", "", ""
+	path := strings.TrimSuffix(strings.TrimRight(u.Path, "/"), "/index.html")
+	u.Path = strings.TrimRight(strings.TrimSuffix(path, "/web"), "/")
+	u.RawPath = ""
+	return u.String()
 }
 
 func NewClient(serverURL string) *Client {
@@ -41,9 +70,10 @@ func NewClient(serverURL string) *Client {
 	cfg.Servers = jellyfin.ServerConfigurations{
 		{URL: serverURL},
 	}
+	cfg.HTTPClient = &http.Client{Transport: netlog.NewTransport("jellyfin")}
 	cfg.AddDefaultHeader("X-Emby-Authorization",
-		fmt.Sprintf(`MediaBrowser Client="%s", Device="%s", DeviceId="jellycouch-1", Version="%s"`,
-			clientName, deviceName, clientVersion))
+		fmt.Sprintf(`MediaBrowser Client="%s", Device="%s", DeviceId="%s", Version="%s"`,
+			clientName, url.PathEscape(deviceName), url.PathEscape(deviceID), clientVersion))
 
 	return &Client{
 		api:       jellyfin.NewAPIClient(cfg),
@@ -78,6 +108,53 @@ func (c *Client) SetToken(token, userID string) {
 	c.api.GetConfig().AddDefaultHeader("X-Emby-Token", c.token)
 }
 
+// IsAdministrator reports whether the signed-in user is a server
+// administrator, from their user policy.
+func (c *Client) IsAdministrator() (bool, error) {
+	user, _, err := c.api.UserAPI.GetCurrentUser(c.reqCtx()).Execute()
+	if err != nil {
+		return false, fmt.Errorf("get current user: %w", err)
+	}
+	policy := user.GetPolicy()
+	return policy.GetIsAdministrator(), nil
+}
+
+// SystemInfo is what a server reports about itself.
+type SystemInfo struct {
+	ServerName string
+	Version    string
+}
+
+// GetSystemInfo fetches the server's name and version. It uses the public
+// endpoint, so it works without signing in.
+func (c *Client) GetSystemInfo() (*SystemInfo, error) {
+	info, _, err := c.api.SystemAPI.GetPublicSystemInfo(c.reqCtx()).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get system info: %w", err)
+	}
+	return &SystemInfo{ServerName: info.GetServerName(), Version: info.GetVersion()}, nil
+}
+
+// CurrentUserName returns the name of the signed-in user.
+func (c *Client) CurrentUserName() (string, error) {
+	user, _, err := c.api.UserAPI.GetCurrentUser(c.reqCtx()).Execute()
+	if err != nil {
+		return "", fmt.Errorf("get current user: %w", err)
+	}
+	return user.GetName(), nil
+}
+
+// LatestItemsExcludes returns the IDs of the views the user has set to be
+// left out of "Latest" in their Jellyfin home screen settings.
+func (c *Client) LatestItemsExcludes() ([]string, error) {
+	user, _, err := c.api.UserAPI.GetCurrentUser(c.reqCtx()).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get current user: %w", err)
+	}
+	cfg := user.GetConfiguration()
+	return cfg.GetLatestItemsExcludes(), nil
+}
+
 func (c *Client) Token() string            { return c.token }
 func (c *Client) UserID() string           { return c.userID }
 func (c *Client) ServerURL() string        { return c.serverURL }
@@ -85,11 +162,16 @@ func (c *Client) API() *jellyfin.APIClient { return c.api }
 func (c *Client) Context() context.Context { return c.ctx }
 
 // reqCtx returns a context with a per-request timeout for API calls.
+func (c *Client) reqCtx() context.Context {
+	return c.timeoutCtx(c.ctx)
+}
+
+// timeoutCtx returns ctx with the per-request timeout added.
 // The cancel function is intentionally not returned because SDK calls are
 // synchronous — the context is cleaned up when the goroutine-scoped
 // deadline timer fires or when the parent context is cancelled.
-func (c *Client) reqCtx() context.Context {
-	ctx, cancel := context.WithTimeout(c.ctx, apiRequestTimeout)
+func (c *Client) timeoutCtx(ctx context.Context) context.Context {
+	ctx, cancel := context.WithTimeout(ctx, apiRequestTimeout)
 	_ = cancel // suppress vet; SDK Execute() calls are synchronous, context will be GC'd
 	return ctx
 }
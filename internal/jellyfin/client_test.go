@@ -0,0 +1,31 @@
+package jellyfin
+
+import "testing"
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"https://jf.example.com", "https://jf.example.com"},
+		{"https://jf.example.com/", "https://jf.example.com"},
+		{"  jf.example.com:8096  ", "https://jf.example.com:8096"},
+		{"http://192.168.1.10:8096/", "http://192.168.1.10:8096"},
+		{"https://example.com/jellyfin", "https://example.com/jellyfin"},
+		{"https://example.com/jellyfin/", "https://example.com/jellyfin"},
+		{"https://example.com/jellyfin/web/#/home.html", "https://example.com/jellyfin"},
+		{"https://example.com/jellyfin/web/index.html#!/home", "https://example.com/jellyfin"},
+		{"https://jf.example.com/web/index.html", "https://jf.example.com"},
+		{"https://jf.example.com/web/", "https://jf.example.com"},
+		{"http://localhost:8096/web/?foo=bar", "http://localhost:8096"},
+		{"http://web:8096", "http://web:8096"},
+		{"http://web:8096/web/", "http://web:8096"},
+		{"web", "https://web"},
+		{"https://example.com/myweb", "https://example.com/myweb"},
+	}
+	for _, tt := range tests {
+		if got := normalizeURL(tt.in); got != tt.want {
+			t.Errorf("normalizeURL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
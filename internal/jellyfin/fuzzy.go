@@ -0,0 +1,150 @@
+package jellyfin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	jellyfin "github.com/sj14/jellyfin-go/api"
+)
+
+// fuzzyCandidateLimit caps how many titles FuzzySearch fetches to rank.
+const fuzzyCandidateLimit = 5000
+
+// FuzzySearch finds movies and series whose names are close to query,
+// tolerating typos, by fetching the library's titles and ranking them
+// locally. It is much heavier than SearchItems, so only use it when
+// SearchItems found nothing.
+func (c *Client) FuzzySearch(query string, limit int) ([]MediaItem, error) {
+	q := normalizeForMatch(query)
+	if len([]rune(q)) < 3 {
+		return nil, nil
+	}
+
+	// Only the base fields: names to rank, and image tags and user data to
+	// draw the posters. The detail screen loads the rest.
+	result, _, err := c.api.ItemsAPI.GetItems(c.reqCtx()).
+		UserId(c.userID).
+		Limit(fuzzyCandidateLimit).
+		Recursive(true).
+		EnableImageTypes([]jellyfin.ImageType{jellyfin.IMAGETYPE_PRIMARY}).
+		ImageTypeLimit(1).
+		EnableTotalRecordCount(false).
+		IncludeItemTypes([]jellyfin.BaseItemKind{
+			jellyfin.BASEITEMKIND_MOVIE,
+			jellyfin.BASEITEMKIND_SERIES,
+		}).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("fuzzy search: %w", err)
+	}
+
+	type match struct {
+		item  MediaItem
+		score float64
+	}
+	var matches []match
+	for _, item := range convertItems(result.Items) {
+		if s := fuzzyScore(q, normalizeForMatch(item.Name)); s > 0 {
+			matches = append(matches, match{item, s})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	items := make([]MediaItem, len(matches))
+	for i, m := range matches {
+		items[i] = m.item
+	}
+	return items, nil
+}
+
+// normalizeForMatch lower-cases s and reduces punctuation and runs of
+// spaces to single spaces.
+func normalizeForMatch(s string) string {
+	var b strings.Builder
+	space := true
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+		} else if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// fuzzyScore rates how well name matches the query q, from 0 (no match) to 1.
+// Both must be normalized. It takes the best edit distance between q and any
+// run of words in name, allowing about one typo per four characters, and
+// falls back to q's letters appearing in order in name.
+func fuzzyScore(q, name string) float64 {
+	if name == "" {
+		return 0
+	}
+	qr := []rune(q)
+	maxDist := max(1, len(qr)/4)
+
+	words := strings.Fields(name)
+	qWords := len(strings.Fields(q))
+	best := -1
+	for n := max(1, qWords-1); n <= qWords+1 && n <= len(words); n++ {
+		for i := 0; i+n <= len(words); i++ {
+			d := levenshtein(qr, []rune(strings.Join(words[i:i+n], " ")))
+			if best < 0 || d < best {
+				best = d
+			}
+		}
+	}
+	if best >= 0 && best <= maxDist {
+		// Prefer matches that cover more of the name
+		coverage := float64(len(qr)) / float64(max(len(qr), len([]rune(name))))
+		return 0.5 + 0.4*(1-float64(best)/float64(len(qr))) + 0.1*coverage
+	}
+
+	// Dropped letters (e.g. "intrstllr"), only when q is most of name
+	nr := []rune(name)
+	if ratio := float64(len(qr)) / float64(len(nr)); ratio >= 0.5 && isSubsequence(qr, nr) {
+		return 0.3 * ratio
+	}
+	return 0
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// isSubsequence reports whether the letters of q appear in s in order.
+func isSubsequence(q, s []rune) bool {
+	i := 0
+	for _, r := range s {
+		if i < len(q) && r == q[i] {
+			i++
+		}
+	}
+	return i == len(q)
+}
@@ -0,0 +1,87 @@
+package jellyfin
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestNormalizeForMatch(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"The Matrix", "the matrix"},
+		{"  Spider-Man: No Way Home  ", "spider man no way home"},
+		{"Amélie", "amélie"},
+		{"WALL·E", "wall e"},
+		{"2001: A Space Odyssey", "2001 a space odyssey"},
+		{"", ""},
+		{"!!!", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeForMatch(tt.in); got != tt.want {
+			t.Errorf("normalizeForMatch(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"matrix", "matrix", 0},
+		{"matrx", "matrix", 1},
+		{"kitten", "sitting", 3},
+		{"amelie", "amélie", 1},
+		{"ééé", "eee", 3},
+	}
+	for _, tt := range tests {
+		if got := levenshtein([]rune(tt.a), []rune(tt.b)); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		q, name string
+		match   bool
+	}{
+		{"matrix", "the matrix", true},
+		{"matirx", "the matrix", false}, // a swap is two edits, too many for six letters
+		{"matrx", "the matrix", true},
+		{"interstelar", "interstellar", true}, // dropped letter
+		{"intrstllr", "interstellar", true},   // dropped vowels
+		{"amelie", "amélie", true},            // diacritics count as one typo
+		{"godfather", "the matrix", false},
+		{"matrix", "", false},
+		{"", "the matrix", false},
+	}
+	for _, tt := range tests {
+		if got := fuzzyScore(tt.q, tt.name); (got > 0) != tt.match {
+			t.Errorf("fuzzyScore(%q, %q) = %v, want match %v", tt.q, tt.name, got, tt.match)
+		}
+	}
+}
+
+func TestFuzzyScoreRanking(t *testing.T) {
+	q := normalizeForMatch("star wars")
+	names := []string{
+		"Star Trek",
+		"Star Wars: The Empire Strikes Back",
+		"Stars Wars",
+		"Star Wars",
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return fuzzyScore(q, normalizeForMatch(names[i])) > fuzzyScore(q, normalizeForMatch(names[j]))
+	})
+	// An exact title first; a one-typo title of about the query's length
+	// before a longer one that contains it; no match last
+	want := []string{"Star Wars", "Stars Wars", "Star Wars: The Empire Strikes Back", "Star Trek"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("ranking = %q, want %q", names, want)
+		}
+	}
+}
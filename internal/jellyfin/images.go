@@ -27,6 +27,12 @@ func (c *Client) GetImageURL(itemID string, imgType ImageType, maxWidth, maxHeig
 		params.Set("maxHeight", fmt.Sprintf("%d", maxHeight))
 	}
 	params.Set("quality", "90")
+	// Ask for a format the image cache can decode, whatever the server stores
+	if imgType == ImageLogo {
+		params.Set("format", "Png") // logos need their transparency
+	} else {
+		params.Set("format", "Jpg")
+	}
 	if len(params) > 0 {
 		u += "?" + params.Encode()
 	}
@@ -1,12 +1,18 @@
 package jellyfin
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"sort"
 
 	jellyfin "github.com/sj14/jellyfin-go/api"
 )
 
+// ErrNotFound is returned (wrapped) for an item the server doesn't have.
+var ErrNotFound = errors.New("not found")
+
 // LibraryFilter holds server-side filter/sort parameters for library queries.
 type LibraryFilter struct {
 	SortBy    string   // SDK ItemSortBy string value
@@ -27,6 +33,7 @@ type MediaItem struct {
 	Overview              string
 	RuntimeTicks          int64
 	CommunityRating       float32
+	CriticRating          float32 // Rotten Tomatoes-style percentage, 0 if unknown
 	ImageTags             map[string]string
 	BackdropTags          []string
 	SeriesID              string
@@ -43,6 +50,23 @@ type MediaItem struct {
 	Genres                []string
 	Taglines              []string
 	OfficialRating        string
+	ProviderIDs           map[string]string // e.g. "Tmdb", "Imdb", "Tvdb"
+	CollectionType        string            // library views only: "movies", "tvshows", "playlists", ...
+	PosterBlurHash        string            // preview of the poster (the series' for episodes), if the server has one
+	Directors             []string          // only filled by GetItem
+	Writers               []string          // only filled by GetItem
+	People                []Person          // cast and crew in billing order; only filled by GetItem
+	Path                  string            // file on the server; only filled by GetItem, for administrators
+	Size                  int64             // file size in bytes; only filled by GetItem
+	Chapters              []int64           // chapter start positions in ticks; only filled by GetItem
+}
+
+// Person is a cast or crew member credited on an item.
+type Person struct {
+	ID   string
+	Name string
+	Role string // character played, for actors
+	Type string // Actor, Director, Writer, GuestStar, ...
 }
 
 type UserData struct {
@@ -59,6 +83,7 @@ var (
 		jellyfin.ITEMFIELDS_PRIMARY_IMAGE_ASPECT_RATIO,
 		jellyfin.ITEMFIELDS_GENRES,
 		jellyfin.ITEMFIELDS_TAGLINES,
+		jellyfin.ITEMFIELDS_PROVIDER_IDS,
 	}
 	defaultImageTypes = []jellyfin.ImageType{
 		jellyfin.IMAGETYPE_PRIMARY,
@@ -69,21 +94,22 @@ var (
 		jellyfin.ITEMFIELDS_OVERVIEW,
 		jellyfin.ITEMFIELDS_GENRES,
 		jellyfin.ITEMFIELDS_TAGLINES,
+		jellyfin.ITEMFIELDS_PROVIDER_IDS,
 	}
 )
 
-// GetViews returns the user's media libraries (Movies, TV Shows, Music, etc.)
-func (c *Client) GetViews() ([]MediaItem, error) {
-	result, _, err := c.api.UserViewsAPI.GetUserViews(c.reqCtx()).UserId(c.userID).Execute()
+// GetViewsContext returns the user's media libraries (Movies, TV Shows, Music, etc.)
+func (c *Client) GetViewsContext(ctx context.Context) ([]MediaItem, error) {
+	result, _, err := c.api.UserViewsAPI.GetUserViews(c.timeoutCtx(ctx)).UserId(c.userID).Execute()
 	if err != nil {
 		return nil, fmt.Errorf("get views: %w", err)
 	}
 	return convertItems(result.Items), nil
 }
 
-// GetLatestMedia returns the latest items in a library.
-func (c *Client) GetLatestMedia(parentID string, limit int) ([]MediaItem, error) {
-	req := c.api.UserLibraryAPI.GetLatestMedia(c.reqCtx()).
+// GetLatestMediaContext returns the latest items in a library.
+func (c *Client) GetLatestMediaContext(ctx context.Context, parentID string, limit int) ([]MediaItem, error) {
+	req := c.api.UserLibraryAPI.GetLatestMedia(c.timeoutCtx(ctx)).
 		UserId(c.userID).
 		Limit(int32(limit)).
 		Fields(defaultFields).
@@ -99,9 +125,9 @@ func (c *Client) GetLatestMedia(parentID string, limit int) ([]MediaItem, error)
 	return convertBaseItemDtoArray(items), nil
 }
 
-// GetItems returns items in a library with pagination.
-func (c *Client) GetItems(parentID string, start, limit int, itemTypes []string) ([]MediaItem, int, error) {
-	req := c.api.ItemsAPI.GetItems(c.reqCtx()).
+// GetItemsContext returns items in a library with pagination.
+func (c *Client) GetItemsContext(ctx context.Context, parentID string, start, limit int, itemTypes []string) ([]MediaItem, int, error) {
+	req := c.api.ItemsAPI.GetItems(c.timeoutCtx(ctx)).
 		UserId(c.userID).
 		StartIndex(int32(start)).
 		Limit(int32(limit)).
@@ -132,8 +158,8 @@ func (c *Client) GetItems(parentID string, start, limit int, itemTypes []string)
 	return convertItems(result.Items), total, nil
 }
 
-// GetFilteredItems returns items in a library with pagination and filtering.
-func (c *Client) GetFilteredItems(parentID string, start, limit int, itemTypes []string, filter LibraryFilter) ([]MediaItem, int, error) {
+// GetFilteredItemsContext returns items in a library with pagination and filtering.
+func (c *Client) GetFilteredItemsContext(ctx context.Context, parentID string, start, limit int, itemTypes []string, filter LibraryFilter) ([]MediaItem, int, error) {
 	sortBy := jellyfin.ItemSortBy(filter.SortBy)
 	if filter.SortBy == "" {
 		sortBy = jellyfin.ITEMSORTBY_SORT_NAME
@@ -143,7 +169,7 @@ func (c *Client) GetFilteredItems(parentID string, start, limit int, itemTypes [
 		sortOrder = jellyfin.SORTORDER_ASCENDING
 	}
 
-	req := c.api.ItemsAPI.GetItems(c.reqCtx()).
+	req := c.api.ItemsAPI.GetItems(c.timeoutCtx(ctx)).
 		UserId(c.userID).
 		StartIndex(int32(start)).
 		Limit(int32(limit)).
@@ -191,9 +217,9 @@ func (c *Client) GetFilteredItems(parentID string, start, limit int, itemTypes [
 	return convertItems(result.Items), total, nil
 }
 
-// GetCollectionType returns the collection type of a library view (e.g. "tvshows", "movies").
-func (c *Client) GetCollectionType(parentID string) (string, error) {
-	item, _, err := c.api.UserLibraryAPI.GetItem(c.ctx, parentID).
+// GetCollectionTypeContext returns the collection type of a library view (e.g. "tvshows", "movies").
+func (c *Client) GetCollectionTypeContext(ctx context.Context, parentID string) (string, error) {
+	item, _, err := c.api.UserLibraryAPI.GetItem(ctx, parentID).
 		UserId(c.userID).
 		Execute()
 	if err != nil {
@@ -202,10 +228,65 @@ func (c *Client) GetCollectionType(parentID string) (string, error) {
 	return string(item.GetCollectionType()), nil
 }
 
-// GetGenres returns genre names for a library, sorted alphabetically.
-func (c *Client) GetGenres(parentID string, itemTypes []string) ([]string, error) {
-	req := c.api.GenresAPI.GetGenres(c.reqCtx()).
-		UserId(c.userID)
+// displayPrefsClient is the client name the web client stores library view
+// settings under, so both share them.
+const displayPrefsClient = "emby"
+
+// DisplayPreferences is the part of a library's server-side view settings
+// JellyCouch uses.
+type DisplayPreferences struct {
+	SortBy    string // e.g. "SortName"; may list tie-breakers after a comma
+	SortOrder string // "Ascending" or "Descending"
+}
+
+// GetDisplayPreferencesContext returns the user's saved view settings for a library.
+func (c *Client) GetDisplayPreferencesContext(ctx context.Context, viewID string) (DisplayPreferences, error) {
+	dto, _, err := c.api.DisplayPreferencesAPI.GetDisplayPreferences(c.timeoutCtx(ctx), viewID).
+		UserId(c.userID).
+		Client(displayPrefsClient).
+		Execute()
+	if err != nil {
+		return DisplayPreferences{}, fmt.Errorf("get display preferences: %w", err)
+	}
+	return DisplayPreferences{SortBy: dto.GetSortBy(), SortOrder: string(dto.GetSortOrder())}, nil
+}
+
+// UpdateDisplayPreferences saves prefs into a library's view settings,
+// keeping the settings JellyCouch doesn't use.
+func (c *Client) UpdateDisplayPreferences(viewID string, prefs DisplayPreferences) error {
+	dto, _, err := c.api.DisplayPreferencesAPI.GetDisplayPreferences(c.reqCtx(), viewID).
+		UserId(c.userID).
+		Client(displayPrefsClient).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("update display preferences: %w", err)
+	}
+	dto.SetSortBy(prefs.SortBy)
+	dto.SetSortOrder(jellyfin.SortOrder(prefs.SortOrder))
+	_, err = c.api.DisplayPreferencesAPI.UpdateDisplayPreferences(c.reqCtx(), viewID).
+		UserId(c.userID).
+		Client(displayPrefsClient).
+		DisplayPreferencesDto(*dto).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("update display preferences: %w", err)
+	}
+	return nil
+}
+
+// maxGenres caps how many genres GetGenres fetches, so a library with
+// thousands of tagged genres stays a single modest request.
+const maxGenres = 1000
+
+// GetGenresContext returns genre names for a library, sorted alphabetically,
+// up to maxGenres of them.
+func (c *Client) GetGenresContext(ctx context.Context, parentID string, itemTypes []string) ([]string, error) {
+	req := c.api.GenresAPI.GetGenres(c.timeoutCtx(ctx)).
+		UserId(c.userID).
+		SortBy([]jellyfin.ItemSortBy{jellyfin.ITEMSORTBY_SORT_NAME}).
+		Limit(maxGenres).
+		EnableImages(false).
+		EnableTotalRecordCount(false)
 	if parentID != "" {
 		req = req.ParentId(parentID)
 	}
@@ -228,9 +309,9 @@ func (c *Client) GetGenres(parentID string, itemTypes []string) ([]string, error
 	return genres, nil
 }
 
-// GetSeasons returns seasons for a series.
-func (c *Client) GetSeasons(seriesID string) ([]MediaItem, error) {
-	result, _, err := c.api.TvShowsAPI.GetSeasons(c.ctx, seriesID).
+// GetSeasonsContext returns seasons for a series.
+func (c *Client) GetSeasonsContext(ctx context.Context, seriesID string) ([]MediaItem, error) {
+	result, _, err := c.api.TvShowsAPI.GetSeasons(ctx, seriesID).
 		UserId(c.userID).
 		Fields(metadataFields).
 		Execute()
@@ -240,9 +321,21 @@ func (c *Client) GetSeasons(seriesID string) ([]MediaItem, error) {
 	return convertItems(result.Items), nil
 }
 
-// GetEpisodes returns episodes for a season.
-func (c *Client) GetEpisodes(seriesID string, seasonID string) ([]MediaItem, error) {
-	req := c.api.TvShowsAPI.GetEpisodes(c.ctx, seriesID).
+// GetSpecialFeaturesContext returns an item's extras: behind the scenes,
+// featurettes, deleted scenes and the like.
+func (c *Client) GetSpecialFeaturesContext(ctx context.Context, itemID string) ([]MediaItem, error) {
+	result, _, err := c.api.UserLibraryAPI.GetSpecialFeatures(c.timeoutCtx(ctx), itemID).
+		UserId(c.userID).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get special features: %w", err)
+	}
+	return convertItems(result), nil
+}
+
+// GetEpisodesContext returns episodes for a season.
+func (c *Client) GetEpisodesContext(ctx context.Context, seriesID string, seasonID string) ([]MediaItem, error) {
+	req := c.api.TvShowsAPI.GetEpisodes(ctx, seriesID).
 		UserId(c.userID).
 		Fields(metadataFields).
 		SeasonId(seasonID)
@@ -253,9 +346,36 @@ func (c *Client) GetEpisodes(seriesID string, seasonID string) ([]MediaItem, err
 	return convertItems(result.Items), nil
 }
 
-// GetResumeItems returns items the user can resume watching.
-func (c *Client) GetResumeItems(limit int) ([]MediaItem, error) {
-	result, _, err := c.api.ItemsAPI.GetResumeItems(c.reqCtx()).
+// GetRandomEpisodeContext returns a random episode of a series, preferring regular
+// seasons over specials. Returns nil if the series has no episodes.
+func (c *Client) GetRandomEpisodeContext(ctx context.Context, seriesID string) (*MediaItem, error) {
+	result, _, err := c.api.ItemsAPI.GetItems(c.timeoutCtx(ctx)).
+		UserId(c.userID).
+		ParentId(seriesID).
+		Recursive(true).
+		IncludeItemTypes([]jellyfin.BaseItemKind{jellyfin.BASEITEMKIND_EPISODE}).
+		SortBy([]jellyfin.ItemSortBy{jellyfin.ITEMSORTBY_RANDOM}).
+		Fields(metadataFields).
+		Limit(10).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get random episode: %w", err)
+	}
+	episodes := convertItems(result.Items)
+	if len(episodes) == 0 {
+		return nil, nil
+	}
+	for _, ep := range episodes {
+		if ep.ParentIndexNumber > 0 {
+			return &ep, nil
+		}
+	}
+	return &episodes[0], nil
+}
+
+// GetResumeItemsContext returns items the user can resume watching.
+func (c *Client) GetResumeItemsContext(ctx context.Context, limit int) ([]MediaItem, error) {
+	result, _, err := c.api.ItemsAPI.GetResumeItems(c.timeoutCtx(ctx)).
 		UserId(c.userID).
 		Limit(int32(limit)).
 		Fields(defaultFields).
@@ -268,9 +388,9 @@ func (c *Client) GetResumeItems(limit int) ([]MediaItem, error) {
 	return convertItems(result.Items), nil
 }
 
-// GetNextUp returns next episodes to watch.
-func (c *Client) GetNextUp(limit int) ([]MediaItem, error) {
-	result, _, err := c.api.TvShowsAPI.GetNextUp(c.reqCtx()).
+// GetNextUpContext returns next episodes to watch.
+func (c *Client) GetNextUpContext(ctx context.Context, limit int) ([]MediaItem, error) {
+	result, _, err := c.api.TvShowsAPI.GetNextUp(c.timeoutCtx(ctx)).
 		UserId(c.userID).
 		Limit(int32(limit)).
 		Fields(metadataFields).
@@ -282,9 +402,32 @@ func (c *Client) GetNextUp(limit int) ([]MediaItem, error) {
 	return convertItems(result.Items), nil
 }
 
-// SearchItems searches for items by name.
-func (c *Client) SearchItems(query string, limit int) ([]MediaItem, error) {
-	result, _, err := c.api.ItemsAPI.GetItems(c.reqCtx()).
+// GetSeriesNextUpContext returns the episode to continue a series with: the
+// partially watched episode or the one after the last watched, falling back to
+// the first episode. Returns nil if every episode has been watched.
+func (c *Client) GetSeriesNextUpContext(ctx context.Context, seriesID string) (*MediaItem, error) {
+	result, _, err := c.api.TvShowsAPI.GetNextUp(c.timeoutCtx(ctx)).
+		UserId(c.userID).
+		SeriesId(seriesID).
+		Limit(1).
+		Fields(metadataFields).
+		EnableUserData(true).
+		EnableResumable(true).
+		DisableFirstEpisode(false).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get series next up: %w", err)
+	}
+	if len(result.Items) == 0 {
+		return nil, nil
+	}
+	item := convertBaseItemDto(&result.Items[0])
+	return &item, nil
+}
+
+// SearchItemsContext searches for items by name.
+func (c *Client) SearchItemsContext(ctx context.Context, query string, limit int) ([]MediaItem, error) {
+	result, _, err := c.api.ItemsAPI.GetItems(c.timeoutCtx(ctx)).
 		UserId(c.userID).
 		SearchTerm(query).
 		Limit(int32(limit)).
@@ -303,11 +446,14 @@ func (c *Client) SearchItems(query string, limit int) ([]MediaItem, error) {
 	return convertItems(result.Items), nil
 }
 
-// GetItem returns a single item by ID.
-func (c *Client) GetItem(itemID string) (*MediaItem, error) {
-	result, _, err := c.api.UserLibraryAPI.GetItem(c.ctx, itemID).
+// GetItemContext returns a single item by ID.
+func (c *Client) GetItemContext(ctx context.Context, itemID string) (*MediaItem, error) {
+	result, resp, err := c.api.UserLibraryAPI.GetItem(ctx, itemID).
 		UserId(c.userID).
 		Execute()
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("get item %s: %w", itemID, ErrNotFound)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("get item: %w", err)
 	}
@@ -315,6 +461,56 @@ func (c *Client) GetItem(itemID string) (*MediaItem, error) {
 	return &item, nil
 }
 
+// maxFilmography caps how many titles GetItemsByPerson returns.
+const maxFilmography = 500
+
+// GetItemsByPersonContext returns the movies and shows in the library that
+// credit a person, newest first.
+func (c *Client) GetItemsByPersonContext(ctx context.Context, personID string) ([]MediaItem, error) {
+	result, _, err := c.api.ItemsAPI.GetItems(c.timeoutCtx(ctx)).
+		UserId(c.userID).
+		PersonIds([]string{personID}).
+		Recursive(true).
+		Limit(maxFilmography).
+		Fields(defaultFields).
+		EnableImageTypes(defaultImageTypes).
+		ImageTypeLimit(1).
+		IncludeItemTypes([]jellyfin.BaseItemKind{
+			jellyfin.BASEITEMKIND_MOVIE,
+			jellyfin.BASEITEMKIND_SERIES,
+		}).
+		SortBy([]jellyfin.ItemSortBy{jellyfin.ITEMSORTBY_PRODUCTION_YEAR, jellyfin.ITEMSORTBY_SORT_NAME}).
+		SortOrder([]jellyfin.SortOrder{jellyfin.SORTORDER_DESCENDING, jellyfin.SORTORDER_ASCENDING}).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get items by person: %w", err)
+	}
+	return convertItems(result.Items), nil
+}
+
+// Metadata refresh modes for RefreshItem
+const (
+	RefreshDefault = "Default"     // fill in missing metadata
+	RefreshFull    = "FullRefresh" // look the item up again and replace its metadata
+)
+
+// RefreshItem queues a metadata and image refresh of an item on the server
+// (POST /Items/{itemId}/Refresh). mode is RefreshDefault or RefreshFull. The
+// server only acknowledges the request; the refresh runs in its task queue.
+// Needs an administrator.
+func (c *Client) RefreshItem(itemID string, mode string) error {
+	refreshMode := jellyfin.MetadataRefreshMode(mode)
+	_, err := c.api.ItemRefreshAPI.RefreshItem(c.reqCtx(), itemID).
+		MetadataRefreshMode(refreshMode).
+		ImageRefreshMode(refreshMode).
+		ReplaceAllMetadata(mode == RefreshFull).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("refresh item: %w", err)
+	}
+	return nil
+}
+
 func convertItems(items []jellyfin.BaseItemDto) []MediaItem {
 	result := make([]MediaItem, 0, len(items))
 	for _, item := range items {
@@ -340,6 +536,21 @@ func convertBaseItemDto(item *jellyfin.BaseItemDto) MediaItem {
 	mi.Overview = item.GetOverview()
 	mi.RuntimeTicks = item.GetRunTimeTicks()
 	mi.CommunityRating = item.GetCommunityRating()
+	mi.CriticRating = item.GetCriticRating()
+	for _, person := range item.GetPeople() {
+		mi.People = append(mi.People, Person{
+			ID:   person.GetId(),
+			Name: person.GetName(),
+			Role: person.GetRole(),
+			Type: string(person.GetType()),
+		})
+		switch person.GetType() {
+		case jellyfin.PERSONKIND_DIRECTOR:
+			mi.Directors = append(mi.Directors, person.GetName())
+		case jellyfin.PERSONKIND_WRITER:
+			mi.Writers = append(mi.Writers, person.GetName())
+		}
+	}
 
 	if len(item.ImageTags) > 0 {
 		mi.ImageTags = make(map[string]string)
@@ -348,6 +559,13 @@ func convertBaseItemDto(item *jellyfin.BaseItemDto) MediaItem {
 		}
 	}
 	mi.BackdropTags = item.BackdropImageTags
+	if hashes := item.GetImageBlurHashes().Primary; hashes != nil {
+		tag := mi.ImageTags["Primary"]
+		if mi.Type == "Episode" && item.GetSeriesId() != "" {
+			tag = item.GetSeriesPrimaryImageTag()
+		}
+		mi.PosterBlurHash = (*hashes)[tag]
+	}
 	mi.SeriesID = item.GetSeriesId()
 	mi.SeriesName = item.GetSeriesName()
 	mi.SeasonID = item.GetSeasonId()
@@ -358,7 +576,19 @@ func convertBaseItemDto(item *jellyfin.BaseItemDto) MediaItem {
 	mi.Genres = item.GetGenres()
 	mi.Taglines = item.GetTaglines()
 	mi.OfficialRating = item.GetOfficialRating()
+	mi.ProviderIDs = item.GetProviderIds()
 	mi.RecursiveItemCount = int(item.GetRecursiveItemCount())
+	mi.CollectionType = string(item.GetCollectionType())
+	mi.Path = item.GetPath()
+	if sources := item.GetMediaSources(); len(sources) > 0 {
+		if mi.Path == "" {
+			mi.Path = sources[0].GetPath()
+		}
+		mi.Size = sources[0].GetSize()
+	}
+	for _, ch := range item.GetChapters() {
+		mi.Chapters = append(mi.Chapters, ch.GetStartPositionTicks())
+	}
 
 	if item.UserData.IsSet() {
 		udPtr := item.UserData.Get()
@@ -0,0 +1,35 @@
+package jellyfin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetItemNotFound(t *testing.T) {
+	tests := []struct {
+		status       int
+		wantNotFound bool
+	}{
+		{http.StatusNotFound, true},
+		{http.StatusInternalServerError, false},
+		{http.StatusUnauthorized, false},
+	}
+	for _, tt := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+		}))
+		c := NewClient(srv.URL)
+		c.SetToken("token", "user")
+		_, err := c.GetItem("missing")
+		srv.Close()
+		if err == nil {
+			t.Errorf("status %d: no error", tt.status)
+			continue
+		}
+		if got := errors.Is(err, ErrNotFound); got != tt.wantNotFound {
+			t.Errorf("status %d: errors.Is(%v, ErrNotFound) = %v, want %v", tt.status, err, got, tt.wantNotFound)
+		}
+	}
+}
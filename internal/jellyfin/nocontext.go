@@ -0,0 +1,100 @@
+package jellyfin
+
+// Context-free forms of the client's fetches, which run under the client's
+// own context like the rest of its methods. New code that can abort its
+// requests, e.g. a screen that is closed, should call the ...Context form.
+
+// GetViews is GetViewsContext with the client's context.
+func (c *Client) GetViews() ([]MediaItem, error) {
+	return c.GetViewsContext(c.ctx)
+}
+
+// GetLatestMedia is GetLatestMediaContext with the client's context.
+func (c *Client) GetLatestMedia(parentID string, limit int) ([]MediaItem, error) {
+	return c.GetLatestMediaContext(c.ctx, parentID, limit)
+}
+
+// GetItems is GetItemsContext with the client's context.
+func (c *Client) GetItems(parentID string, start, limit int, itemTypes []string) ([]MediaItem, int, error) {
+	return c.GetItemsContext(c.ctx, parentID, start, limit, itemTypes)
+}
+
+// GetFilteredItems is GetFilteredItemsContext with the client's context.
+func (c *Client) GetFilteredItems(parentID string, start, limit int, itemTypes []string, filter LibraryFilter) ([]MediaItem, int, error) {
+	return c.GetFilteredItemsContext(c.ctx, parentID, start, limit, itemTypes, filter)
+}
+
+// GetCollectionType is GetCollectionTypeContext with the client's context.
+func (c *Client) GetCollectionType(parentID string) (string, error) {
+	return c.GetCollectionTypeContext(c.ctx, parentID)
+}
+
+// GetDisplayPreferences is GetDisplayPreferencesContext with the client's context.
+func (c *Client) GetDisplayPreferences(viewID string) (DisplayPreferences, error) {
+	return c.GetDisplayPreferencesContext(c.ctx, viewID)
+}
+
+// GetGenres is GetGenresContext with the client's context.
+func (c *Client) GetGenres(parentID string, itemTypes []string) ([]string, error) {
+	return c.GetGenresContext(c.ctx, parentID, itemTypes)
+}
+
+// GetSeasons is GetSeasonsContext with the client's context.
+func (c *Client) GetSeasons(seriesID string) ([]MediaItem, error) {
+	return c.GetSeasonsContext(c.ctx, seriesID)
+}
+
+// GetSpecialFeatures is GetSpecialFeaturesContext with the client's context.
+func (c *Client) GetSpecialFeatures(itemID string) ([]MediaItem, error) {
+	return c.GetSpecialFeaturesContext(c.ctx, itemID)
+}
+
+// GetEpisodes is GetEpisodesContext with the client's context.
+func (c *Client) GetEpisodes(seriesID string, seasonID string) ([]MediaItem, error) {
+	return c.GetEpisodesContext(c.ctx, seriesID, seasonID)
+}
+
+// GetRandomEpisode is GetRandomEpisodeContext with the client's context.
+func (c *Client) GetRandomEpisode(seriesID string) (*MediaItem, error) {
+	return c.GetRandomEpisodeContext(c.ctx, seriesID)
+}
+
+// GetResumeItems is GetResumeItemsContext with the client's context.
+func (c *Client) GetResumeItems(limit int) ([]MediaItem, error) {
+	return c.GetResumeItemsContext(c.ctx, limit)
+}
+
+// GetNextUp is GetNextUpContext with the client's context.
+func (c *Client) GetNextUp(limit int) ([]MediaItem, error) {
+	return c.GetNextUpContext(c.ctx, limit)
+}
+
+// GetSeriesNextUp is GetSeriesNextUpContext with the client's context.
+func (c *Client) GetSeriesNextUp(seriesID string) (*MediaItem, error) {
+	return c.GetSeriesNextUpContext(c.ctx, seriesID)
+}
+
+// SearchItems is SearchItemsContext with the client's context.
+func (c *Client) SearchItems(query string, limit int) ([]MediaItem, error) {
+	return c.SearchItemsContext(c.ctx, query, limit)
+}
+
+// GetItem is GetItemContext with the client's context.
+func (c *Client) GetItem(itemID string) (*MediaItem, error) {
+	return c.GetItemContext(c.ctx, itemID)
+}
+
+// GetItemsByPerson is GetItemsByPersonContext with the client's context.
+func (c *Client) GetItemsByPerson(personID string) ([]MediaItem, error) {
+	return c.GetItemsByPersonContext(c.ctx, personID)
+}
+
+// GetPlaylists is GetPlaylistsContext with the client's context.
+func (c *Client) GetPlaylists() ([]MediaItem, error) {
+	return c.GetPlaylistsContext(c.ctx)
+}
+
+// GetPlaylistItems is GetPlaylistItemsContext with the client's context.
+func (c *Client) GetPlaylistItems(playlistID string) ([]MediaItem, error) {
+	return c.GetPlaylistItemsContext(c.ctx, playlistID)
+}
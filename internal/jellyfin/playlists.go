@@ -0,0 +1,53 @@
+package jellyfin
+
+import (
+	"context"
+	"fmt"
+
+	jellyfin "github.com/sj14/jellyfin-go/api"
+)
+
+// GetPlaylistsContext returns the user's playlists, sorted by name.
+func (c *Client) GetPlaylistsContext(ctx context.Context) ([]MediaItem, error) {
+	result, _, err := c.api.ItemsAPI.GetItems(c.timeoutCtx(ctx)).
+		UserId(c.userID).
+		IncludeItemTypes([]jellyfin.BaseItemKind{jellyfin.BASEITEMKIND_PLAYLIST}).
+		Recursive(true).
+		Fields(defaultFields).
+		EnableImageTypes(defaultImageTypes).
+		ImageTypeLimit(1).
+		SortBy([]jellyfin.ItemSortBy{jellyfin.ITEMSORTBY_SORT_NAME}).
+		SortOrder([]jellyfin.SortOrder{jellyfin.SORTORDER_ASCENDING}).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get playlists: %w", err)
+	}
+	return convertItems(result.Items), nil
+}
+
+// GetPlaylistItemsContext returns the entries of a playlist in playlist order.
+func (c *Client) GetPlaylistItemsContext(ctx context.Context, playlistID string) ([]MediaItem, error) {
+	result, _, err := c.api.PlaylistsAPI.GetPlaylistItems(c.timeoutCtx(ctx), playlistID).
+		UserId(c.userID).
+		Fields(defaultFields).
+		EnableImageTypes(defaultImageTypes).
+		ImageTypeLimit(1).
+		EnableUserData(true).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get playlist items: %w", err)
+	}
+	return convertItems(result.Items), nil
+}
+
+// AddToPlaylist appends items to the end of a playlist.
+func (c *Client) AddToPlaylist(playlistID string, itemIDs ...string) error {
+	_, err := c.api.PlaylistsAPI.AddItemToPlaylist(c.reqCtx(), playlistID).
+		Ids(itemIDs).
+		UserId(c.userID).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("add to playlist: %w", err)
+	}
+	return nil
+}
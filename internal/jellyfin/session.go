@@ -1,12 +1,41 @@
 package jellyfin
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	jellyfin "github.com/sj14/jellyfin-go/api"
 )
 
+// Capabilities reported for this device's session: what the player plays,
+// and the remote commands it carries out. There are none until the session
+// WebSocket delivering GeneralCommand messages is handled, so other clients
+// don't send volume or track changes that would go nowhere.
+var (
+	playableMediaTypes = []jellyfin.MediaType{jellyfin.MEDIATYPE_VIDEO, jellyfin.MEDIATYPE_AUDIO}
+	supportedCommands  = []jellyfin.GeneralCommandType{}
+)
+
+// ReportCapabilities tells the server what this session plays and which
+// commands it supports, so the dashboard lists it as a player. Media control
+// stays off until the session WebSocket carrying Playstate and GeneralCommand
+// messages is handled; until then other clients would offer this device as
+// a remote control target that never responds.
+func (c *Client) ReportCapabilities() error {
+	_, err := c.api.SessionAPI.PostCapabilities(c.reqCtx()).
+		PlayableMediaTypes(playableMediaTypes).
+		SupportedCommands(supportedCommands).
+		SupportsMediaControl(false).
+		SupportsPersistentIdentifier(true).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("report capabilities: %w", err)
+	}
+	return nil
+}
+
 // ReportPlaybackStart notifies the server that playback has started.
 func (c *Client) ReportPlaybackStart(itemID string, positionTicks int64) error {
 	body := *jellyfin.NewPlaybackStartInfo()
@@ -63,6 +92,50 @@ func (c *Client) MarkPlayed(itemID string) error {
 	return nil
 }
 
+// batchWorkers bounds the concurrent requests of the batch calls.
+const batchWorkers = 4
+
+// MarkPlayedBatch marks several items as played. The server has no batch
+// endpoint, so the requests run a few at a time; all are attempted and the
+// failures are returned together.
+func (c *Client) MarkPlayedBatch(itemIDs []string) error {
+	return runBatch(itemIDs, c.MarkPlayed)
+}
+
+// MarkUnplayedBatch is MarkPlayedBatch for marking items unplayed.
+func (c *Client) MarkUnplayedBatch(itemIDs []string) error {
+	return runBatch(itemIDs, c.MarkUnplayed)
+}
+
+// SetFavoriteBatch is SetFavorite for several items, run like MarkPlayedBatch.
+func (c *Client) SetFavoriteBatch(itemIDs []string, favorite bool) error {
+	return runBatch(itemIDs, func(id string) error { return c.SetFavorite(id, favorite) })
+}
+
+// runBatch calls fn for every ID, batchWorkers at a time, and joins the errors.
+func runBatch(itemIDs []string, fn func(id string) error) error {
+	ids := make(chan string)
+	errs := make([]error, batchWorkers)
+	var wg sync.WaitGroup
+	for w := range batchWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				if err := fn(id); err != nil {
+					errs[w] = errors.Join(errs[w], err)
+				}
+			}
+		}()
+	}
+	for _, id := range itemIDs {
+		ids <- id
+	}
+	close(ids)
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
 // MarkUnplayed marks an item as unplayed.
 func (c *Client) MarkUnplayed(itemID string) error {
 	_, _, err := c.api.PlaystateAPI.MarkUnplayedItem(c.ctx, itemID).
@@ -73,3 +146,37 @@ func (c *Client) MarkUnplayed(itemID string) error {
 	}
 	return nil
 }
+
+// SetFavorite adds or removes an item from the user's favorites.
+func (c *Client) SetFavorite(itemID string, favorite bool) error {
+	var err error
+	if favorite {
+		_, _, err = c.api.UserLibraryAPI.MarkFavoriteItem(c.reqCtx(), itemID).
+			UserId(c.userID).
+			Execute()
+	} else {
+		_, _, err = c.api.UserLibraryAPI.UnmarkFavoriteItem(c.reqCtx(), itemID).
+			UserId(c.userID).
+			Execute()
+	}
+	if err != nil {
+		return fmt.Errorf("set favorite: %w", err)
+	}
+	return nil
+}
+
+// RemoveFromResume drops an item from Continue Watching by resetting its saved
+// playback position (POST /UserItems/{itemId}/UserData). The played state is untouched.
+func (c *Client) RemoveFromResume(itemID string) error {
+	body := *jellyfin.NewUpdateUserItemDataDto()
+	body.SetPlaybackPositionTicks(0)
+
+	_, _, err := c.api.ItemsAPI.UpdateItemUserData(c.reqCtx(), itemID).
+		UserId(c.userID).
+		UpdateUserItemDataDto(body).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("remove from resume: %w", err)
+	}
+	return nil
+}
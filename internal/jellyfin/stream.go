@@ -1,16 +1,60 @@
 package jellyfin
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net/url"
+	"strconv"
 )
 
+// StreamOptions selects how an item is streamed.
+type StreamOptions struct {
+	// Transcode asks the server for an H.264/AAC HLS stream instead of the
+	// original file, for when the client can't decode the source.
+	Transcode bool
+	// MaxBitrate caps the transcoded stream in bits per second (0 = server default).
+	MaxBitrate int
+}
+
+// newPlaySessionID returns a random ID for one transcoded playback. The
+// server keys its transcoding jobs on it, so two playbacks sharing an ID
+// would stop or reuse each other's.
+func newPlaySessionID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 // GetStreamURL returns a direct-play streaming URL for an item.
 func (c *Client) GetStreamURL(itemID string) string {
+	return c.GetStreamURLWithOptions(itemID, StreamOptions{})
+}
+
+// GetStreamURLWithOptions returns a streaming URL for an item: the original
+// file by default, or a transcoded HLS stream if opts.Transcode is set.
+func (c *Client) GetStreamURLWithOptions(itemID string, opts StreamOptions) string {
+	if !opts.Transcode {
+		params := url.Values{}
+		params.Set("Static", "true")
+		params.Set("api_key", c.token)
+		return fmt.Sprintf("%s/Videos/%s/stream?%s",
+			c.serverURL, url.PathEscape(itemID), params.Encode())
+	}
+
 	params := url.Values{}
-	params.Set("Static", "true")
 	params.Set("api_key", c.token)
-	return fmt.Sprintf("%s/Videos/%s/stream?%s",
+	params.Set("DeviceId", deviceID)
+	params.Set("PlaySessionId", newPlaySessionID())
+	params.Set("MediaSourceId", itemID)
+	params.Set("VideoCodec", "h264")
+	params.Set("AudioCodec", "aac")
+	params.Set("TranscodingMaxAudioChannels", "2")
+	if opts.MaxBitrate > 0 {
+		params.Set("MaxStreamingBitrate", strconv.Itoa(opts.MaxBitrate))
+		params.Set("VideoBitrate", strconv.Itoa(opts.MaxBitrate))
+	}
+	return fmt.Sprintf("%s/Videos/%s/master.m3u8?%s",
 		c.serverURL, url.PathEscape(itemID), params.Encode())
 }
 
@@ -18,8 +62,8 @@ func (c *Client) GetStreamURL(itemID string) string {
 func (c *Client) GetHLSStreamURL(itemID string) string {
 	params := url.Values{}
 	params.Set("api_key", c.token)
-	params.Set("DeviceId", "jellycouch-1")
-	params.Set("PlaySessionId", "jellycouch-session")
+	params.Set("DeviceId", deviceID)
+	params.Set("PlaySessionId", newPlaySessionID())
 	return fmt.Sprintf("%s/Videos/%s/master.m3u8?%s",
 		c.serverURL, url.PathEscape(itemID), params.Encode())
 }
@@ -0,0 +1,27 @@
+package jellyfin
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestStreamURLsUseNewPlaySessionIDs(t *testing.T) {
+	c := NewClient("https://jf.example.com")
+	seen := map[string]bool{}
+	for _, u := range []string{
+		c.GetStreamURLWithOptions("item", StreamOptions{Transcode: true}),
+		c.GetStreamURLWithOptions("item", StreamOptions{Transcode: true}),
+		c.GetHLSStreamURL("item"),
+		c.GetHLSStreamURL("item"),
+	} {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := parsed.Query().Get("PlaySessionId")
+		if id == "" || seen[id] {
+			t.Errorf("%s: PlaySessionId %q is empty or reused", u, id)
+		}
+		seen[id] = true
+	}
+}
@@ -3,11 +3,15 @@ package jellyseerr
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
+
+	"github.com/depeter/jellycouch/internal/netlog"
 )
 
 // API endpoint paths.
@@ -20,8 +24,12 @@ const (
 	pathRequestCount   = "/api/v1/request/count"
 	pathMovie          = "/api/v1/movie"
 	pathTV             = "/api/v1/tv"
+	pathPerson         = "/api/v1/person"
+	pathMedia          = "/api/v1/media"
 	pathRadarr         = "/api/v1/settings/radarr"
 	pathSonarr         = "/api/v1/settings/sonarr"
+	pathAuthMe         = "/api/v1/auth/me"
+	pathUser           = "/api/v1/user"
 )
 
 // Client is a lightweight HTTP client for the Jellyseerr API.
@@ -31,17 +39,47 @@ type Client struct {
 	httpClient *http.Client
 }
 
+// APIError is returned when the server answers with a non-success status.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Body)
+}
+
+// NormalizeURL trims trailing slashes and defaults the scheme to https. A
+// base path behind a reverse proxy ("https://host/seerr") is kept; API paths
+// are appended to it.
+// It returns an error if the result is not a usable http(s) URL with a host.
+func NormalizeURL(raw string) (string, error) {
+	raw = strings.TrimRight(strings.TrimSpace(raw), "/")
+	if !strings.HasPrefix(raw, "http://") && !strings.HasPrefix(raw, "https://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw, fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Host == "" || strings.ContainsAny(u.Host, " /") {
+		return raw, errors.New("invalid URL: missing host")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return raw, errors.New("invalid URL: query and fragment not allowed")
+	}
+	return raw, nil
+}
+
 // NewClient creates a new Jellyseerr API client.
 func NewClient(baseURL, apiKey string) *Client {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
-		baseURL = "https://" + baseURL
-	}
+	baseURL, _ = NormalizeURL(baseURL)
 	return &Client{
 		baseURL: baseURL,
 		apiKey:  apiKey,
 		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
+			Timeout:   15 * time.Second,
+			Transport: netlog.NewTransport("jellyseerr"),
 		},
 	}
 }
@@ -63,25 +101,32 @@ func (c *Client) get(path string, dst interface{}) error {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	return json.NewDecoder(resp.Body).Decode(dst)
 }
 
 // post performs an authenticated POST request with a JSON body and decodes the response into dst.
+// A nil body sends an empty request.
 func (c *Client) post(path string, body interface{}, dst interface{}) error {
-	jsonBody, err := json.Marshal(body)
-	if err != nil {
-		return fmt.Errorf("marshal body: %w", err)
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal body: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequest("POST", c.baseURL+path, bytes.NewReader(jsonBody))
+	req, err := http.NewRequest("POST", c.baseURL+path, reqBody)
 	if err != nil {
 		return fmt.Errorf("build request: %w", err)
 	}
 	req.Header.Set("X-Api-Key", c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := c.httpClient.Do(req)
@@ -92,7 +137,7 @@ func (c *Client) post(path string, body interface{}, dst interface{}) error {
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	if dst != nil {
@@ -100,3 +145,56 @@ func (c *Client) post(path string, body interface{}, dst interface{}) error {
 	}
 	return nil
 }
+
+// GetCurrentUser returns the user the API key authenticates as.
+func (c *Client) GetCurrentUser() (*RequestUser, error) {
+	var user RequestUser
+	if err := c.get(pathAuthMe, &user); err != nil {
+		return nil, fmt.Errorf("get current user: %w", err)
+	}
+	return &user, nil
+}
+
+// usersPageSize is how many users GetUsers fetches per call.
+const usersPageSize = 100
+
+// GetUsers returns every Jellyseerr user. Requires the manage users
+// permission.
+func (c *Client) GetUsers() ([]RequestUser, error) {
+	var users []RequestUser
+	for skip := 0; ; skip += usersPageSize {
+		var resp UsersResponse
+		path := fmt.Sprintf("%s?take=%d&skip=%d&sort=displayname", pathUser, usersPageSize, skip)
+		if err := c.get(path, &resp); err != nil {
+			return nil, fmt.Errorf("get users: %w", err)
+		}
+		users = append(users, resp.Results...)
+		if len(resp.Results) < usersPageSize || len(users) >= resp.PageInfo.Results {
+			return users, nil
+		}
+	}
+}
+
+// Validate checks that the server is reachable and accepts the API key.
+// It returns the user the key authenticates as.
+func (c *Client) Validate() (*RequestUser, error) {
+	user, err := c.GetCurrentUser()
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return nil, errors.New("API key rejected")
+		case http.StatusNotFound:
+			return nil, errors.New("not a Jellyseerr server (404)")
+		}
+		return nil, fmt.Errorf("server returned %d", apiErr.StatusCode)
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, errors.New("not a Jellyseerr server (unexpected response)")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -1,6 +1,14 @@
 package jellyseerr
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// recentlyAvailablePageSize is how many titles GetRecentlyAvailable returns
+// per page.
+const recentlyAvailablePageSize = 20
 
 // GetMovie fetches detailed movie info by TMDB ID, including request status.
 func (c *Client) GetMovie(tmdbID int) (*MovieDetail, error) {
@@ -19,3 +27,107 @@ func (c *Client) GetTV(tmdbID int) (*TVDetail, error) {
 	}
 	return &detail, nil
 }
+
+// GetPerson fetches a person by TMDB ID along with the movies and shows they
+// are known for, taken from their cast and crew credits.
+func (c *Client) GetPerson(tmdbID int) (*PersonDetail, error) {
+	var detail PersonDetail
+	if err := c.get(fmt.Sprintf("%s/%d", pathPerson, tmdbID), &detail); err != nil {
+		return nil, fmt.Errorf("get person: %w", err)
+	}
+	var credits PersonCredits
+	if err := c.get(fmt.Sprintf("%s/%d/combined_credits", pathPerson, tmdbID), &credits); err != nil {
+		return nil, fmt.Errorf("get person credits: %w", err)
+	}
+
+	// A director who also wrote or produced appears once per job
+	seen := make(map[string]bool)
+	for _, r := range append(credits.Cast, credits.Crew...) {
+		if r.MediaType != "movie" && r.MediaType != "tv" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", r.MediaType, r.ID)
+		if !seen[key] {
+			seen[key] = true
+			detail.KnownFor = append(detail.KnownFor, r)
+		}
+	}
+	sort.SliceStable(detail.KnownFor, func(i, j int) bool {
+		return detail.KnownFor[i].Popularity > detail.KnownFor[j].Popularity
+	})
+	return &detail, nil
+}
+
+// GetRecentlyAvailable returns the media that most recently became
+// available, newest first, as a page of search results. The media list has
+// no titles or posters, so each entry's detail is fetched as well; entries
+// whose detail fails to load are left out.
+func (c *Client) GetRecentlyAvailable(page int) (*SearchResponse, error) {
+	path := fmt.Sprintf("%s?filter=allavailable&sort=mediaAdded&take=%d&skip=%d",
+		pathMedia, recentlyAvailablePageSize, (page-1)*recentlyAvailablePageSize)
+	var list MediaListResponse
+	if err := c.get(path, &list); err != nil {
+		return nil, fmt.Errorf("get recently available: %w", err)
+	}
+
+	results := make([]*SearchResult, len(list.Results))
+	var wg sync.WaitGroup
+	for i, m := range list.Results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.mediaSearchResult(m)
+		}()
+	}
+	wg.Wait()
+
+	resp := &SearchResponse{
+		Page:         page,
+		TotalPages:   list.PageInfo.Pages,
+		TotalResults: list.PageInfo.Results,
+	}
+	for _, r := range results {
+		if r != nil {
+			resp.Results = append(resp.Results, *r)
+		}
+	}
+	return resp, nil
+}
+
+// mediaSearchResult fills a search result for m from its movie or TV detail,
+// or returns nil if that fails.
+func (c *Client) mediaSearchResult(m MediaListItem) *SearchResult {
+	switch m.MediaType {
+	case "movie":
+		d, err := c.GetMovie(m.TmdbID)
+		if err != nil {
+			return nil
+		}
+		return &SearchResult{
+			ID:          d.ID,
+			MediaType:   "movie",
+			Title:       d.Title,
+			PosterPath:  d.PosterPath,
+			Overview:    d.Overview,
+			ReleaseDate: d.ReleaseDate,
+			VoteAverage: d.VoteAverage,
+			MediaInfo:   d.MediaInfo,
+		}
+	case "tv":
+		d, err := c.GetTV(m.TmdbID)
+		if err != nil {
+			return nil
+		}
+		return &SearchResult{
+			ID:           d.ID,
+			MediaType:    "tv",
+			Name:         d.Name,
+			PosterPath:   d.PosterPath,
+			Overview:     d.Overview,
+			FirstAirDate: d.FirstAirDate,
+			VoteAverage:  d.VoteAverage,
+			MediaInfo:    d.MediaInfo,
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ func (c *Client) CreateRequest(mediaID int, mediaType string, seasons []int, opt
 		body.RootFolder = opts.RootFolder
 		body.LanguageProfileID = opts.LanguageProfileID
 		body.Is4K = opts.Is4K
+		body.UserID = opts.UserID
 	}
 	var result MediaRequest
 	if err := c.post(pathRequest, body, &result); err != nil {
@@ -28,7 +29,8 @@ func (c *Client) CreateRequest(mediaID int, mediaType string, seasons []int, opt
 
 // GetRequests fetches a list of requests with optional status filter.
 // filter can be: "all", "approved", "pending", "declined", "processing", "available" or empty for all.
-func (c *Client) GetRequests(filter string, take, skip int) ([]MediaRequest, int, error) {
+// requestedBy limits the list to one user's requests; 0 returns everyone's.
+func (c *Client) GetRequests(filter string, requestedBy, take, skip int) ([]MediaRequest, int, error) {
 	v := url.Values{}
 	v.Set("take", fmt.Sprintf("%d", take))
 	v.Set("skip", fmt.Sprintf("%d", skip))
@@ -36,6 +38,9 @@ func (c *Client) GetRequests(filter string, take, skip int) ([]MediaRequest, int
 	if filter != "" && filter != "all" {
 		v.Set("filter", filter)
 	}
+	if requestedBy != 0 {
+		v.Set("requestedBy", fmt.Sprintf("%d", requestedBy))
+	}
 	var resp RequestsResponse
 	if err := c.get(pathRequest+"?"+v.Encode(), &resp); err != nil {
 		return nil, 0, fmt.Errorf("get requests: %w", err)
@@ -51,3 +56,19 @@ func (c *Client) GetRequestCount() (*RequestCount, error) {
 	}
 	return &count, nil
 }
+
+// ApproveRequest approves a pending request. Requires the manage requests permission.
+func (c *Client) ApproveRequest(requestID int) error {
+	if err := c.post(fmt.Sprintf("%s/%d/approve", pathRequest, requestID), nil, nil); err != nil {
+		return fmt.Errorf("approve request: %w", err)
+	}
+	return nil
+}
+
+// DeclineRequest declines a pending request. Requires the manage requests permission.
+func (c *Client) DeclineRequest(requestID int) error {
+	if err := c.post(fmt.Sprintf("%s/%d/decline", pathRequest, requestID), nil, nil); err != nil {
+		return fmt.Errorf("decline request: %w", err)
+	}
+	return nil
+}
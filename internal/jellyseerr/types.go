@@ -1,6 +1,11 @@
 package jellyseerr
 
-import "github.com/depeter/jellycouch/internal/constants"
+import (
+	"sort"
+	"strings"
+
+	"github.com/depeter/jellycouch/internal/constants"
+)
 
 // Media status values from Jellyseerr API.
 const (
@@ -18,6 +23,13 @@ const (
 	RequestDeclined = 3
 )
 
+// User permission bits from Jellyseerr API.
+const (
+	PermissionAdmin          = 2
+	PermissionManageUsers    = 8
+	PermissionManageRequests = 16
+)
+
 // SearchResult represents a single search result from Jellyseerr (TMDB data).
 type SearchResult struct {
 	ID           int        `json:"id"`
@@ -25,10 +37,12 @@ type SearchResult struct {
 	Title        string     `json:"title"`     // movies
 	Name         string     `json:"name"`      // tv shows
 	PosterPath   string     `json:"posterPath"`
+	ProfilePath  string     `json:"profilePath"` // people
 	Overview     string     `json:"overview"`
 	ReleaseDate  string     `json:"releaseDate"`  // movies
 	FirstAirDate string     `json:"firstAirDate"` // tv
 	VoteAverage  float64    `json:"voteAverage"`
+	Popularity   float64    `json:"popularity"`
 	MediaInfo    *MediaInfo `json:"mediaInfo"`
 }
 
@@ -52,29 +66,62 @@ func (sr SearchResult) Year() string {
 	return ""
 }
 
-// PosterURL returns the full TMDB poster URL.
+// PosterURL returns the full TMDB poster URL, or the profile photo for people.
 func (sr SearchResult) PosterURL() string {
-	if sr.PosterPath == "" {
+	path := sr.PosterPath
+	if path == "" {
+		path = sr.ProfilePath
+	}
+	if path == "" {
 		return ""
 	}
-	return constants.TMDBPosterW300 + sr.PosterPath
+	return constants.TMDBPosterW300 + path
 }
 
 // MediaInfo contains request/availability status for a media item.
 type MediaInfo struct {
 	ID       int            `json:"id"`
 	Status   int            `json:"status"`
+	Status4K int            `json:"status4k"`
 	Requests []MediaRequest `json:"requests"`
+	Seasons  []SeasonStatus `json:"seasons"` // TV only
+
+	JellyfinMediaID string `json:"jellyfinMediaId"` // library item, once available
+}
+
+// MediaListItem is an entry of the media list endpoint. It carries no title
+// or poster; those come from the movie or TV detail.
+type MediaListItem struct {
+	ID        int    `json:"id"`
+	TmdbID    int    `json:"tmdbId"`
+	MediaType string `json:"mediaType"`
+	Status    int    `json:"status"`
+}
+
+// MediaListResponse is the response from the media list endpoint.
+type MediaListResponse struct {
+	PageInfo PageInfo        `json:"pageInfo"`
+	Results  []MediaListItem `json:"results"`
+}
+
+// SeasonStatus is the status of one season, in MediaInfo (media status)
+// or MediaRequest (request status).
+type SeasonStatus struct {
+	SeasonNumber int `json:"seasonNumber"`
+	Status       int `json:"status"`
+	Status4K     int `json:"status4k"`
 }
 
 // MediaRequest represents a request in Jellyseerr.
 type MediaRequest struct {
-	ID          int          `json:"id"`
-	Status      int          `json:"status"` // 1=pending, 2=approved, 3=declined
-	Type        string       `json:"type"`   // "movie" or "tv"
-	Media       RequestMedia `json:"media"`
-	CreatedAt   string       `json:"createdAt"`
-	RequestedBy RequestUser  `json:"requestedBy"`
+	ID          int            `json:"id"`
+	Status      int            `json:"status"` // 1=pending, 2=approved, 3=declined
+	Type        string         `json:"type"`   // "movie" or "tv"
+	Media       RequestMedia   `json:"media"`
+	CreatedAt   string         `json:"createdAt"`
+	RequestedBy RequestUser    `json:"requestedBy"`
+	Is4K        bool           `json:"is4k"`
+	Seasons     []SeasonStatus `json:"seasons"` // TV only
 }
 
 // RequestMedia is the media info embedded in a request.
@@ -90,6 +137,25 @@ type RequestMedia struct {
 type RequestUser struct {
 	ID          int    `json:"id"`
 	DisplayName string `json:"displayName"`
+	Permissions int    `json:"permissions"`
+}
+
+// CanManageRequests reports whether the user may approve or decline requests.
+func (u *RequestUser) CanManageRequests() bool {
+	return u.Permissions&(PermissionAdmin|PermissionManageRequests) != 0
+}
+
+// CanRequestOnBehalf reports whether the user may make requests for other
+// users, which needs both the users and the requests permission.
+func (u *RequestUser) CanRequestOnBehalf() bool {
+	const both = PermissionManageUsers | PermissionManageRequests
+	return u.Permissions&PermissionAdmin != 0 || u.Permissions&both == both
+}
+
+// UsersResponse is the response from the user list endpoint.
+type UsersResponse struct {
+	PageInfo PageInfo      `json:"pageInfo"`
+	Results  []RequestUser `json:"results"`
 }
 
 // RequestCount holds aggregate request counts.
@@ -140,6 +206,39 @@ type RelatedVideo struct {
 	Site string `json:"site"` // "YouTube", etc.
 }
 
+// YouTubeURL returns the watch URL of a YouTube video.
+func (v RelatedVideo) YouTubeURL() string {
+	return "https://www.youtube.com/watch?v=" + v.Key
+}
+
+// trailerRank orders trailers before teasers, and official videos first
+// within each.
+func trailerRank(v RelatedVideo) int {
+	rank := 0
+	if v.Type != "Trailer" {
+		rank += 2
+	}
+	if !strings.Contains(strings.ToLower(v.Name), "official") {
+		rank++
+	}
+	return rank
+}
+
+// playableTrailers returns the YouTube trailers and teasers in videos, best
+// first.
+func playableTrailers(videos []RelatedVideo) []RelatedVideo {
+	var out []RelatedVideo
+	for _, v := range videos {
+		if (v.Type == "Trailer" || v.Type == "Teaser") && v.Site == "YouTube" && v.Key != "" {
+			out = append(out, v)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return trailerRank(out[i]) < trailerRank(out[j])
+	})
+	return out
+}
+
 // MovieDetail contains detailed movie info from Jellyseerr.
 type MovieDetail struct {
 	ID            int            `json:"id"`
@@ -152,16 +251,39 @@ type MovieDetail struct {
 	MediaInfo     *MediaInfo     `json:"mediaInfo"`
 }
 
-// TrailerURL returns the full YouTube URL for the first trailer, or "".
+// Trailers returns the playable trailers and teasers, the official trailer
+// first.
+func (d *MovieDetail) Trailers() []RelatedVideo {
+	return playableTrailers(d.RelatedVideos)
+}
+
+// TrailerURL returns the full YouTube URL for the best trailer, or "".
 func (d *MovieDetail) TrailerURL() string {
-	for _, v := range d.RelatedVideos {
-		if v.Type == "Trailer" && v.Site == "YouTube" && v.Key != "" {
-			return "https://www.youtube.com/watch?v=" + v.Key
-		}
+	if t := d.Trailers(); len(t) > 0 {
+		return t[0].YouTubeURL()
 	}
 	return ""
 }
 
+// PersonDetail contains a person's TMDB info and the titles they are known for.
+type PersonDetail struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Biography          string `json:"biography"`
+	ProfilePath        string `json:"profilePath"`
+	KnownForDepartment string `json:"knownForDepartment"`
+	Birthday           string `json:"birthday"`
+	PlaceOfBirth       string `json:"placeOfBirth"`
+	// KnownFor is filled from the combined credits, most popular first.
+	KnownFor []SearchResult `json:"-"`
+}
+
+// PersonCredits is the response from the person combined credits endpoint.
+type PersonCredits struct {
+	Cast []SearchResult `json:"cast"`
+	Crew []SearchResult `json:"crew"`
+}
+
 // TVDetail contains detailed TV show info from Jellyseerr.
 type TVDetail struct {
 	ID              int            `json:"id"`
@@ -176,12 +298,16 @@ type TVDetail struct {
 	MediaInfo       *MediaInfo     `json:"mediaInfo"`
 }
 
-// TrailerURL returns the full YouTube URL for the first trailer, or "".
+// Trailers returns the playable trailers and teasers, the official trailer
+// first.
+func (d *TVDetail) Trailers() []RelatedVideo {
+	return playableTrailers(d.RelatedVideos)
+}
+
+// TrailerURL returns the full YouTube URL for the best trailer, or "".
 func (d *TVDetail) TrailerURL() string {
-	for _, v := range d.RelatedVideos {
-		if v.Type == "Trailer" && v.Site == "YouTube" && v.Key != "" {
-			return "https://www.youtube.com/watch?v=" + v.Key
-		}
+	if t := d.Trailers(); len(t) > 0 {
+		return t[0].YouTubeURL()
 	}
 	return ""
 }
@@ -204,6 +330,7 @@ type CreateRequestBody struct {
 	RootFolder        string `json:"rootFolder,omitempty"`
 	LanguageProfileID int    `json:"languageProfileId,omitempty"`
 	Is4K              bool   `json:"is4k,omitempty"`
+	UserID            int    `json:"userId,omitempty"` // request on behalf of this user
 }
 
 // RequestOptions holds optional parameters for creating a request.
@@ -213,6 +340,7 @@ type RequestOptions struct {
 	RootFolder        string
 	LanguageProfileID int
 	Is4K              bool
+	UserID            int // request on behalf of another user; 0 is the API key's user
 }
 
 // ServiceProfile represents a quality profile from Radarr/Sonarr.
@@ -0,0 +1,92 @@
+// Package logging sends the standard logger and slog to stderr and a
+// size-rotated log file in the config directory.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/depeter/jellycouch/internal/constants"
+)
+
+// level is the minimum level of slog messages only. log.Printf messages,
+// which include every failure report, bypass it and are logged as info.
+var level slog.LevelVar
+
+// Setup starts logging to path, rotating it once it reaches maxSizeMB.
+// Logging to stderr continues if the file can't be opened. The returned
+// closer flushes and closes the file.
+func Setup(path, levelName string, maxSizeMB int) (io.Closer, error) {
+	if err := SetLevel(levelName); err != nil {
+		log.Printf("Log level: %v, using info", err)
+	}
+
+	file, err := openRotating(path, int64(maxSizeMB)<<20, logBackups)
+	var w io.Writer = os.Stderr
+	if err == nil {
+		w = &teeWriter{file: file}
+	}
+
+	handler := slog.NewTextHandler(w, &slog.HandlerOptions{Level: &level})
+	slog.SetDefault(slog.New(handler))
+	log.SetOutput(printfWriter{handler})
+
+	slog.Info("JellyCouch starting",
+		"version", constants.AppVersion,
+		"os", runtime.GOOS,
+		"arch", runtime.GOARCH,
+		"go", runtime.Version())
+
+	if err != nil {
+		return nopCloser{}, fmt.Errorf("open log file: %w", err)
+	}
+	return file, nil
+}
+
+// SetLevel changes the minimum level: "debug", "info", "warn" or "error".
+func SetLevel(name string) error {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+		level.Set(slog.LevelInfo)
+		return fmt.Errorf("unknown level %q", name)
+	}
+	level.Set(l)
+	return nil
+}
+
+// teeWriter writes to the log file and stderr. Stderr errors are ignored,
+// since a Windows GUI build may have no console.
+type teeWriter struct {
+	file *rotatingFile
+}
+
+func (t *teeWriter) Write(p []byte) (int, error) {
+	os.Stderr.Write(p)
+	return t.file.Write(p)
+}
+
+// printfWriter hands log.Printf output to the handler as info. Unlike the
+// bridge slog.SetDefault installs, it skips the level check, so a warn or
+// error level still shows failures.
+type printfWriter struct {
+	h slog.Handler
+}
+
+func (w printfWriter) Write(p []byte) (int, error) {
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, strings.TrimSuffix(string(p), "\n"), 0)
+	if err := w.h.Handle(context.Background(), r); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
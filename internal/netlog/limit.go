@@ -0,0 +1,38 @@
+package netlog
+
+import (
+	"net/http"
+	"sync"
+)
+
+// MaxRequestsPerHost caps how many requests are in flight to one host across
+// every Transport, so metadata and image loads together don't swamp a
+// low-powered server. 0 means no cap. Set it before the first request.
+var MaxRequestsPerHost = 6
+
+var (
+	slotsMu sync.Mutex
+	slots   = map[string]chan struct{}{} // host -> semaphore
+)
+
+// acquire waits for a free slot for req's host, or for req to be cancelled.
+// The returned release must be called once the request is done.
+func acquire(req *http.Request) (release func(), err error) {
+	if MaxRequestsPerHost <= 0 {
+		return func() {}, nil
+	}
+	slotsMu.Lock()
+	sem := slots[req.URL.Host]
+	if sem == nil {
+		sem = make(chan struct{}, MaxRequestsPerHost)
+		slots[req.URL.Host] = sem
+	}
+	slotsMu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
@@ -0,0 +1,140 @@
+// Package netlog keeps a short in-memory log of recent HTTP requests for the
+// debug overlay, and limits how many run at once per host.
+package netlog
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// bufferSize is the number of requests kept.
+const bufferSize = 64
+
+// Entry is one completed (or failed) HTTP request.
+type Entry struct {
+	Time     time.Time // when the request started
+	Source   string    // "jellyfin", "jellyseerr", "image"
+	Method   string
+	Path     string // URL path without the query, which may carry tokens
+	Duration time.Duration
+	Status   int    // 0 if the request failed before a response
+	Err      string // transport error, if any
+}
+
+var (
+	mu      sync.Mutex
+	entries [bufferSize]Entry
+	next    int // index the next entry is written to
+	count   int
+)
+
+// Record adds an entry, overwriting the oldest once the buffer is full.
+func Record(e Entry) {
+	mu.Lock()
+	defer mu.Unlock()
+	entries[next] = e
+	next = (next + 1) % bufferSize
+	if count < bufferSize {
+		count++
+	}
+}
+
+// Recent returns up to n entries, newest first.
+func Recent(n int) []Entry {
+	mu.Lock()
+	defer mu.Unlock()
+	if n > count {
+		n = count
+	}
+	out := make([]Entry, n)
+	for i := 0; i < n; i++ {
+		out[i] = entries[(next-1-i+bufferSize)%bufferSize]
+	}
+	return out
+}
+
+// SharedTransport is the connection pool behind every Transport without a
+// Base, so the Jellyfin, Jellyseerr and image clients reuse each other's
+// keep-alive connections. It keeps more idle connections per host than
+// http.DefaultTransport, enough for MaxRequestsPerHost, and tries HTTP/2.
+var SharedTransport = func() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = 64
+	t.MaxIdleConnsPerHost = 16
+	t.IdleConnTimeout = 120 * time.Second
+	t.ForceAttemptHTTP2 = true
+	return t
+}()
+
+// Transport is an http.RoundTripper that records each request it sends. It
+// waits for a slot under MaxRequestsPerHost before sending.
+type Transport struct {
+	Source string
+	Base   http.RoundTripper // nil means SharedTransport
+}
+
+// NewTransport returns a Transport for source wrapping SharedTransport.
+func NewTransport(source string) *Transport {
+	return &Transport{Source: source}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = SharedTransport
+	}
+	release, err := acquire(req)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+
+	e := Entry{
+		Time:     start,
+		Source:   t.Source,
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		e.Err = err.Error()
+	} else {
+		e.Status = resp.StatusCode
+	}
+	Record(e)
+	slog.Debug("http request", "source", e.Source, "method", e.Method, "path", e.Path,
+		"status", e.Status, "duration", e.Duration, "err", e.Err)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	// The slot is held until the body has been read, or until the request's
+	// context ends, so a body nobody closes can't keep it forever
+	body := &releaseBody{ReadCloser: resp.Body, release: release}
+	body.stop = context.AfterFunc(req.Context(), body.free)
+	resp.Body = body
+	return resp, nil
+}
+
+// releaseBody frees a request's slot when its body is closed or its
+// request's context is done, whichever comes first.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+	stop    func() bool // stops the context watch
+	once    sync.Once
+}
+
+func (b *releaseBody) free() { b.once.Do(b.release) }
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.stop()
+	b.free()
+	return err
+}
@@ -0,0 +1,70 @@
+package netlog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSlotReleasedWhenContextEnds(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+	defer func(n int) { MaxRequestsPerHost = n }(MaxRequestsPerHost)
+	MaxRequestsPerHost = 1
+	client := &http.Client{Transport: NewTransport("test")}
+
+	// A response whose body is never closed holds the host's only slot
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, "GET", srv.URL, nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, "GET", srv.URL, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request after the first one's context ended: %v", err)
+	}
+	resp.Body.Close()
+}
+
+// BenchmarkTransport compares small requests through SharedTransport, which
+// reuses keep-alive connections, with a transport dialing for each request.
+func BenchmarkTransport(b *testing.B) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Items":[]}`))
+	}))
+	defer srv.Close()
+
+	benches := []struct {
+		name string
+		base http.RoundTripper
+	}{
+		{"shared", nil},
+		{"no-keepalive", &http.Transport{DisableKeepAlives: true}},
+	}
+	for _, bb := range benches {
+		b.Run(bb.name, func(b *testing.B) {
+			client := &http.Client{Transport: &Transport{Source: "bench", Base: bb.base}}
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					resp, err := client.Get(srv.URL)
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package netlog
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ConfigureTLS sets how SharedTransport verifies server certificates.
+// caFile names a PEM file of extra CA certificates to trust besides the
+// system ones, for servers with a self-signed or private CA certificate.
+// insecure skips verification altogether. Call it before the first request.
+func ConfigureTLS(caFile string, insecure bool) error {
+	cfg := &tls.Config{InsecureSkipVerify: insecure}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return fmt.Errorf("read CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("read CA file: no PEM certificates in %s", caFile)
+		}
+		cfg.RootCAs = pool
+	}
+	SharedTransport.TLSClientConfig = cfg
+	return nil
+}
+
+// TLSErrorHint explains a certificate verification failure in err for the
+// user, pointing at the settings that fix it. It returns "" if err isn't one.
+func TLSErrorHint(err error) string {
+	var (
+		unknownCA x509.UnknownAuthorityError
+		hostname  x509.HostnameError
+		invalid   x509.CertificateInvalidError
+	)
+	switch {
+	case errors.As(err, &unknownCA):
+		return "The server's certificate is not trusted (self-signed or private CA). " +
+			"Set server.ca_file to its CA certificate, or server.insecure_skip_verify = true."
+	case errors.As(err, &hostname):
+		return "The server's certificate is for a different host name than this URL."
+	case errors.As(err, &invalid):
+		return "The server's certificate is invalid or expired."
+	}
+	var verify *tls.CertificateVerificationError
+	if errors.As(err, &verify) {
+		return "The server's certificate could not be verified: " + verify.Err.Error()
+	}
+	return ""
+}
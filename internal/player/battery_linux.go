@@ -0,0 +1,29 @@
+//go:build linux
+
+package player
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// readBattery returns the first battery's charge percentage from sysfs.
+// ok is false on machines without a battery.
+func readBattery() (percent int, charging bool, ok bool) {
+	dirs, _ := filepath.Glob("/sys/class/power_supply/BAT*")
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(dir, "capacity"))
+		if err != nil {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(string(data)))
+		if err != nil {
+			continue
+		}
+		status, _ := os.ReadFile(filepath.Join(dir, "status"))
+		return n, strings.TrimSpace(string(status)) == "Charging", true
+	}
+	return 0, false, false
+}
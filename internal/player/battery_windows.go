@@ -0,0 +1,35 @@
+//go:build windows
+
+package player
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32                 = syscall.NewLazyDLL("kernel32.dll")
+	procGetSystemPowerStatus = kernel32.NewProc("GetSystemPowerStatus")
+)
+
+// systemPowerStatus mirrors the Win32 SYSTEM_POWER_STATUS struct.
+type systemPowerStatus struct {
+	ACLineStatus        byte
+	BatteryFlag         byte
+	BatteryLifePercent  byte
+	SystemStatusFlag    byte
+	BatteryLifeTime     uint32
+	BatteryFullLifeTime uint32
+}
+
+// readBattery returns the battery charge percentage via GetSystemPowerStatus.
+// ok is false on machines without a battery.
+func readBattery() (percent int, charging bool, ok bool) {
+	var s systemPowerStatus
+	ret, _, _ := procGetSystemPowerStatus.Call(uintptr(unsafe.Pointer(&s)))
+	// BatteryFlag 128 = no system battery, 255 = unknown; percent 255 = unknown
+	if ret == 0 || s.BatteryFlag == 128 || s.BatteryFlag == 255 || s.BatteryLifePercent == 255 {
+		return 0, false, false
+	}
+	return int(s.BatteryLifePercent), s.BatteryFlag&8 != 0, true
+}
@@ -0,0 +1,103 @@
+package player
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Bookmark is a named position within a single item.
+type Bookmark struct {
+	Name    string  `json:"name"`
+	Seconds float64 `json:"seconds"`
+}
+
+// BookmarkStore holds per-item bookmarks, persisted as JSON on disk.
+// These are local to this client and independent of the server's resume position.
+type BookmarkStore struct {
+	path  string
+	mu    sync.Mutex
+	items map[string][]Bookmark // itemID -> bookmarks sorted by position
+}
+
+// LoadBookmarks reads the bookmark file at path. A missing file yields an empty store.
+func LoadBookmarks(path string) (*BookmarkStore, error) {
+	bs := &BookmarkStore{path: path, items: make(map[string][]Bookmark)}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return bs, nil
+		}
+		return bs, err
+	}
+	if err := json.Unmarshal(data, &bs.items); err != nil {
+		return bs, fmt.Errorf("parse bookmarks: %w", err)
+	}
+	return bs, nil
+}
+
+// List returns a copy of the bookmarks for itemID, ordered by position.
+func (bs *BookmarkStore) List(itemID string) []Bookmark {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	return append([]Bookmark(nil), bs.items[itemID]...)
+}
+
+// Add stores a bookmark at seconds for itemID and saves the file.
+// An empty name is replaced with "Bookmark N". Returns the stored bookmark.
+func (bs *BookmarkStore) Add(itemID, name string, seconds float64) (Bookmark, error) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if name == "" {
+		name = fmt.Sprintf("Bookmark %d", len(bs.items[itemID])+1)
+	}
+	bm := Bookmark{Name: name, Seconds: seconds}
+	list := append(bs.items[itemID], bm)
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Seconds < list[j].Seconds })
+	bs.items[itemID] = list
+	return bm, bs.save()
+}
+
+// Remove deletes the bookmark at index for itemID and saves the file.
+func (bs *BookmarkStore) Remove(itemID string, index int) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	list := bs.items[itemID]
+	if index < 0 || index >= len(list) {
+		return nil
+	}
+	list = append(list[:index:index], list[index+1:]...)
+	if len(list) == 0 {
+		delete(bs.items, itemID)
+	} else {
+		bs.items[itemID] = list
+	}
+	return bs.save()
+}
+
+// save writes the store to disk. Caller must hold mu.
+func (bs *BookmarkStore) save() error {
+	return saveJSON(bs.path, bs.items)
+}
+
+// saveJSON writes v to path as indented JSON, through a temporary file so a
+// crash never leaves half a file.
+func saveJSON(path string, v any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
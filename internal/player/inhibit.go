@@ -0,0 +1,54 @@
+package player
+
+import (
+	"sync"
+	"time"
+)
+
+// inhibitInterval is how often the idle timer is reset while inhibiting.
+// Screensavers rarely start sooner than a minute.
+const inhibitInterval = 30 * time.Second
+
+// SleepInhibitor keeps the screensaver and display sleep away while video
+// plays. The zero value is ready to use; Start and Stop may be called any
+// number of times.
+type SleepInhibitor struct {
+	mu      sync.Mutex
+	stop    chan struct{} // nil when not inhibiting
+	release func()
+}
+
+// Start inhibits display sleep until Stop.
+func (s *SleepInhibitor) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		return
+	}
+	s.stop = make(chan struct{})
+	s.release = inhibitStart()
+	go func(stop chan struct{}) {
+		t := time.NewTicker(inhibitInterval)
+		defer t.Stop()
+		for {
+			inhibitPoke()
+			select {
+			case <-t.C:
+			case <-stop:
+				return
+			}
+		}
+	}(s.stop)
+}
+
+// Stop lets the display sleep again.
+func (s *SleepInhibitor) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop == nil {
+		return
+	}
+	close(s.stop)
+	s.stop = nil
+	s.release()
+}
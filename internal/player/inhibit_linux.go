@@ -0,0 +1,43 @@
+//go:build linux
+
+package player
+
+/*
+#cgo LDFLAGS: -lX11
+#include <X11/Xlib.h>
+
+static void resetX11ScreenSaver() {
+    Display *d = XOpenDisplay(NULL);
+    if (!d) return;
+    XResetScreenSaver(d);
+    XCloseDisplay(d);
+}
+*/
+import "C"
+
+import (
+	"log"
+	"os/exec"
+)
+
+// inhibitStart takes an idle inhibitor lock from logind over D-Bus, via
+// systemd-inhibit, which holds it for as long as its child runs. Without
+// systemd only the X11 reset in inhibitPoke is left.
+func inhibitStart() (release func()) {
+	cmd := exec.Command("systemd-inhibit", "--what=idle", "--who=JellyCouch",
+		"--why=Playing video", "--mode=block", "sleep", "infinity")
+	if err := cmd.Start(); err != nil {
+		log.Printf("Sleep inhibitor: %v", err)
+		return func() {}
+	}
+	return func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}
+}
+
+// inhibitPoke resets the X server's screensaver and DPMS timers, for
+// screensavers that ignore logind.
+func inhibitPoke() {
+	C.resetX11ScreenSaver()
+}
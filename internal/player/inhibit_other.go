@@ -0,0 +1,11 @@
+//go:build !linux && !windows
+
+package player
+
+// inhibitStart is a no-op on platforms without a sleep inhibitor.
+func inhibitStart() (release func()) {
+	return func() {}
+}
+
+// inhibitPoke is a no-op on platforms without a sleep inhibitor.
+func inhibitPoke() {}
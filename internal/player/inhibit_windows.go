@@ -0,0 +1,22 @@
+//go:build windows
+
+package player
+
+var procSetThreadExecutionState = kernel32.NewProc("SetThreadExecutionState")
+
+const (
+	esSystemRequired  = 0x00000001
+	esDisplayRequired = 0x00000002
+)
+
+// inhibitStart has nothing to take on Windows; inhibitPoke does the work.
+func inhibitStart() (release func()) {
+	return func() {}
+}
+
+// inhibitPoke resets the display and system idle timers. Without
+// ES_CONTINUOUS the call is not tied to the calling thread, which matters
+// because goroutines move between threads.
+func inhibitPoke() {
+	procSetThreadExecutionState.Call(esSystemRequired | esDisplayRequired)
+}
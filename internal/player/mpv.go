@@ -1,6 +1,7 @@
 package player
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"runtime"
@@ -31,7 +32,13 @@ type Player struct {
 	position float64
 	itemID   string
 
+	// Seeks holds the configured seek amounts used by keybinds and the overlay.
+	Seeks SeekConfig
+
 	OnPlaybackEnd func()
+	// OnPlaybackError is called instead of OnPlaybackEnd when mpv stops
+	// because the file could not be played, with a reason for the user.
+	OnPlaybackError func(reason string)
 }
 
 // New creates and initializes a new mpv player instance.
@@ -39,6 +46,7 @@ type Player struct {
 func New(cfg *config.Config) (*Player, error) {
 	p := &Player{
 		cmdCh: make(chan playerCmd, 8),
+		Seeks: NewSeekConfig(cfg.Playback),
 	}
 
 	initErr := make(chan error, 1)
@@ -50,6 +58,21 @@ func New(cfg *config.Config) (*Player, error) {
 	return p, nil
 }
 
+// ErrUnavailable wraps errors from New when libmpv could not be set up at all.
+var ErrUnavailable = errors.New("mpv is not available")
+
+// InstallHint tells the user how to get a working libmpv on this platform.
+func InstallHint() string {
+	switch runtime.GOOS {
+	case "windows":
+		return "Download libmpv for Windows (mpv-dev from sourceforge.net/projects/mpv-player-windows) and put libmpv-2.dll next to jellycouch.exe."
+	case "darwin":
+		return "Install mpv with Homebrew: brew install mpv."
+	default:
+		return "Install mpv and libmpv with your package manager, e.g. sudo apt install mpv libmpv2, then restart JellyCouch."
+	}
+}
+
 func must(err error) {
 	if err != nil {
 		log.Printf("mpv option warning: %v", err)
@@ -123,11 +146,16 @@ func (p *Player) mpvThread(cfg *config.Config, initErr chan<- error) {
 	// Volume
 	must(m.SetOptionString("volume", fmt.Sprintf("%d", cfg.Playback.Volume)))
 
+	// Picture adjustments
+	must(m.SetOptionString("brightness", fmt.Sprintf("%d", cfg.Playback.Brightness)))
+	must(m.SetOptionString("contrast", fmt.Sprintf("%d", cfg.Playback.Contrast)))
+	must(m.SetOptionString("gamma", fmt.Sprintf("%d", cfg.Playback.Gamma)))
+
 	// Enable yt-dlp for YouTube URLs (trailers, etc.)
 	must(m.SetOptionString("ytdl", "yes"))
 
 	if err := m.Initialize(); err != nil {
-		initErr <- fmt.Errorf("mpv init: %w", err)
+		initErr <- fmt.Errorf("%w: mpv init: %v", ErrUnavailable, err)
 		return
 	}
 
@@ -201,8 +229,10 @@ func (p *Player) mpvThread(cfg *config.Config, initErr chan<- error) {
 			wasPlaying := p.playing
 			p.playing = false
 			p.mu.Unlock()
-			log.Printf("mpv end-file: reason=%s wasPlaying=%v", ef.Reason, wasPlaying)
-			if wasPlaying && p.OnPlaybackEnd != nil {
+			log.Printf("mpv end-file: reason=%s error=%v wasPlaying=%v", ef.Reason, ef.Error, wasPlaying)
+			if wasPlaying && ef.Reason == mpv.EndFileError && p.OnPlaybackError != nil {
+				p.OnPlaybackError(endFileReason(ef.Error))
+			} else if wasPlaying && p.OnPlaybackEnd != nil {
 				p.OnPlaybackEnd()
 			}
 
@@ -212,6 +242,25 @@ func (p *Player) mpvThread(cfg *config.Config, initErr chan<- error) {
 	}
 }
 
+// endFileReason explains an end-file error in terms the user can act on.
+func endFileReason(err error) string {
+	switch {
+	case errors.Is(err, mpv.ErrLoadingFailed):
+		return "The stream could not be opened. The server may be unreachable or the file missing."
+	case errors.Is(err, mpv.ErrUnknownFormat):
+		return "The file format is not recognized."
+	case errors.Is(err, mpv.ErrNothingToPlay):
+		return "No audio or video could be decoded. The codec may not be supported."
+	case errors.Is(err, mpv.ErrAoInitFailed):
+		return "The audio output could not be started."
+	case errors.Is(err, mpv.ErrVoInitFailed):
+		return "The video output could not be started. Try another hardware decoding setting."
+	case err != nil:
+		return "mpv stopped with an error: " + err.Error() + "."
+	}
+	return "mpv stopped with an error."
+}
+
 // do sends a command to the mpv thread and waits for the result.
 func (p *Player) do(fn func(m *mpv.Mpv) error) error {
 	ch := make(chan error, 1)
@@ -260,6 +309,38 @@ func (p *Player) SeekAbsolute(seconds float64) error {
 	})
 }
 
+// SeekToTimecode seeks exactly to an absolute "hh:mm:ss[.ms]" position.
+func (p *Player) SeekToTimecode(tc string) error {
+	secs, err := ParseTimecode(tc)
+	if err != nil {
+		return err
+	}
+	return p.do(func(m *mpv.Mpv) error {
+		return m.CommandString(mpvCmd("seek", fmt.Sprintf("%.3f", secs), "absolute+exact"))
+	})
+}
+
+// FrameStep advances by a single video frame. mpv pauses after the step.
+func (p *Player) FrameStep() error {
+	return p.do(func(m *mpv.Mpv) error {
+		return m.CommandString(mpvCmd("frame-step"))
+	})
+}
+
+// FrameBackStep steps back by a single video frame. mpv pauses after the step.
+func (p *Player) FrameBackStep() error {
+	return p.do(func(m *mpv.Mpv) error {
+		return m.CommandString(mpvCmd("frame-back-step"))
+	})
+}
+
+// ShowPreciseTime displays the current position with millisecond precision.
+func (p *Player) ShowPreciseTime() {
+	p.do(func(m *mpv.Mpv) error {
+		return m.CommandString(mpvCmd("show-text", "${time-pos/full} / ${duration/full}", "2000"))
+	})
+}
+
 // TogglePause toggles pause state.
 func (p *Player) TogglePause() error {
 	return p.do(func(m *mpv.Mpv) error {
@@ -274,6 +355,18 @@ func (p *Player) SetVolume(vol int) error {
 	})
 }
 
+// Volume returns mpv's current volume, and false if it could not be read.
+func (p *Player) Volume() (int, bool) {
+	var vol float64
+	ok := false
+	p.do(func(m *mpv.Mpv) error {
+		_, err := fmt.Sscanf(m.GetPropertyString("volume"), "%g", &vol)
+		ok = err == nil
+		return err
+	})
+	return int(vol + 0.5), ok
+}
+
 // AdjustVolume changes volume by a relative amount.
 func (p *Player) AdjustVolume(delta int) error {
 	return p.do(func(m *mpv.Mpv) error {
@@ -281,6 +374,46 @@ func (p *Player) AdjustVolume(delta int) error {
 	})
 }
 
+// Picture properties adjusted with AdjustPicture, each from -100 to 100.
+const (
+	PictureBrightness = "brightness"
+	PictureContrast   = "contrast"
+	PictureGamma      = "gamma"
+)
+
+// SetBrightness sets the video brightness (-100 to 100, 0 = unchanged).
+func (p *Player) SetBrightness(v int) error {
+	return p.setPicture(PictureBrightness, v)
+}
+
+// SetContrast sets the video contrast (-100 to 100, 0 = unchanged).
+func (p *Player) SetContrast(v int) error {
+	return p.setPicture(PictureContrast, v)
+}
+
+// SetGamma sets the video gamma (-100 to 100, 0 = unchanged).
+func (p *Player) SetGamma(v int) error {
+	return p.setPicture(PictureGamma, v)
+}
+
+func (p *Player) setPicture(prop string, v int) error {
+	return p.do(func(m *mpv.Mpv) error {
+		return m.SetPropertyString(prop, fmt.Sprintf("%d", max(-100, min(100, v))))
+	})
+}
+
+// AdjustPicture changes a Picture* property by delta and shows the new
+// value on the OSD. mpv keeps it within range.
+func (p *Player) AdjustPicture(prop string, delta int) error {
+	return p.do(func(m *mpv.Mpv) error {
+		if err := m.CommandString(mpvCmd("add", prop, fmt.Sprintf("%d", delta))); err != nil {
+			return err
+		}
+		label := strings.ToUpper(prop[:1]) + prop[1:]
+		return m.CommandString(mpvCmd("show-text", label+": ${"+prop+"}", "1500"))
+	})
+}
+
 // ShowProgress flashes the OSD progress bar.
 func (p *Player) ShowProgress() {
 	p.do(func(m *mpv.Mpv) error {
@@ -424,6 +557,18 @@ func (p *Player) SetAudioTrack(id int) error {
 	})
 }
 
+// SetVideo turns the video track on or off. With it off, mpv plays the
+// audio alone and leaves the window to the UI.
+func (p *Player) SetVideo(on bool) error {
+	vid := "no"
+	if on {
+		vid = "auto"
+	}
+	return p.do(func(m *mpv.Mpv) error {
+		return m.SetPropertyString("vid", vid)
+	})
+}
+
 // ToggleMute toggles audio mute.
 func (p *Player) ToggleMute() error {
 	return p.do(func(m *mpv.Mpv) error {
@@ -1,8 +1,13 @@
 package player
 
 import (
+	"log"
+	"slices"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/depeter/jellycouch/internal/config"
 )
 
 // OverlayMode represents the current state of the playback overlay.
@@ -13,14 +18,19 @@ const (
 	OverlayBar                     // Control bar visible at bottom
 	OverlayTrackSelect             // Track selection panel (modal)
 	OverlayNextUp                  // "Up Next" countdown banner (top-left)
+	OverlayBookmarks               // Bookmark list (modal)
 )
 
 // OSD overlay slot IDs for persistent overlays (via osd-overlay command).
 const (
 	osdIDClock     = 1
 	osdIDPausedBar = 2
+	osdIDProgress  = 3
 )
 
+// defaultHideDelay is the control bar auto-hide delay when none is configured.
+const defaultHideDelay = 4 * time.Second
+
 // ControlButton identifies a button on the control bar.
 type ControlButton int
 
@@ -37,6 +47,48 @@ const (
 	btnCount // sentinel for wrapping
 )
 
+// buttonNames maps the names used in playback.overlay_buttons to buttons.
+var buttonNames = map[string]ControlButton{
+	"back_large":    BtnSeekBack60,
+	"back_small":    BtnSeekBack10,
+	"play_pause":    BtnPlayPause,
+	"stop":          BtnStop,
+	"forward_small": BtnSeekFwd10,
+	"forward_large": BtnSeekFwd60,
+	"subtitles":     BtnSubtitles,
+	"audio":         BtnAudio,
+	"next":          BtnNext,
+}
+
+// defaultButtons is the control bar layout when none (or an invalid one) is configured.
+var defaultButtons = []ControlButton{
+	BtnSeekBack60, BtnSeekBack10, BtnPlayPause, BtnStop,
+	BtnSeekFwd10, BtnSeekFwd60, BtnSubtitles, BtnAudio, BtnNext,
+}
+
+// parseButtons resolves configured button names in order. An unknown name,
+// or a list with nothing but BtnNext (which may be hidden), falls back to
+// defaultButtons; duplicates are dropped.
+func parseButtons(names []string) []ControlButton {
+	var buttons []ControlButton
+	seen := make(map[ControlButton]bool)
+	for _, name := range names {
+		btn, ok := buttonNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			log.Printf("playback.overlay_buttons: unknown button %q, using the default set", name)
+			return defaultButtons
+		}
+		if !seen[btn] {
+			seen[btn] = true
+			buttons = append(buttons, btn)
+		}
+	}
+	if len(buttons) == 0 || (len(buttons) == 1 && buttons[0] == BtnNext) {
+		return defaultButtons
+	}
+	return buttons
+}
+
 // Direction represents an input direction.
 type Direction int
 
@@ -58,9 +110,10 @@ const (
 
 // Playback control constants.
 const (
-	SeekSmall  = 10  // seconds for small seek
-	SeekLarge  = 60  // seconds for large seek
-	VolumeStep = 5   // percent per volume adjustment
+	SeekSmall   = 10 // seconds for small seek
+	SeekLarge   = 60 // seconds for large seek
+	VolumeStep  = 5  // percent per volume adjustment
+	PictureStep = 2  // brightness/contrast/gamma per adjustment
 )
 
 // seekAccel tracks acceleration state for rapid seek presses.
@@ -70,8 +123,40 @@ type seekAccel struct {
 	stepIndex int
 }
 
-// seekSteps defines the escalating seek amounts in seconds.
-var seekSteps = [...]float64{10, 30, 60, 300, 600}
+// defaultSeekSteps defines the escalating seek amounts in seconds.
+var defaultSeekSteps = []float64{10, 30, 60, 300, 600}
+
+// SeekConfig holds seek amounts in seconds. Small/Large apply to the direct
+// seek keybinds and the bar's seek buttons; Accel is the escalation curve used
+// when repeatedly pressing Left/Right on the progress bar (each press within
+// 1s moves one step further along Accel).
+type SeekConfig struct {
+	Small float64
+	Large float64
+	Accel []float64
+}
+
+// NewSeekConfig builds a SeekConfig from playback settings, falling back to
+// the defaults for unset or non-positive values.
+func NewSeekConfig(cfg config.PlaybackConfig) SeekConfig {
+	sc := SeekConfig{Small: SeekSmall, Large: SeekLarge, Accel: defaultSeekSteps}
+	if cfg.SeekSmall > 0 {
+		sc.Small = cfg.SeekSmall
+	}
+	if cfg.SeekLarge > 0 {
+		sc.Large = cfg.SeekLarge
+	}
+	var accel []float64
+	for _, s := range cfg.SeekAccel {
+		if s > 0 {
+			accel = append(accel, s)
+		}
+	}
+	if len(accel) > 0 {
+		sc.Accel = accel
+	}
+	return sc
+}
 
 // PlaybackOverlay manages the Kodi-style OSD rendered via mpv's show-text.
 type PlaybackOverlay struct {
@@ -81,6 +166,7 @@ type PlaybackOverlay struct {
 	hideDelay time.Duration
 
 	// Control bar state
+	buttons    []ControlButton // configured layout, before filtering BtnNext
 	focusedBtn ControlButton
 	focusZone  FocusZone
 	accel      seekAccel
@@ -89,6 +175,12 @@ type PlaybackOverlay struct {
 	// Screen dimensions for resolution-dependent scaling
 	screenW, screenH int
 
+	// Bookmarks for the playing item (nil disables the feature)
+	Bookmarks *BookmarkStore
+
+	// FormatClock writes the corner clock; nil uses a 24-hour clock
+	FormatClock func(t time.Time) string
+
 	// Callbacks
 	OnStop        func()
 	OnNextEpisode func()
@@ -109,28 +201,58 @@ type PlaybackOverlay struct {
 	// Paused persistent OSD state
 	pausedOsdShown bool
 
+	// Always-on progress line state
+	progressLine      bool
+	progressLineShown bool
+	lastLineRender    time.Time
+
+	// Corner clock/battery state
+	showClock   bool
+	showBattery bool
+	battery     batteryStatus
+
 	// Track selection state
 	trackType     TrackType
 	tracks        []Track
 	selectedIndex int
+
+	// Bookmark panel state (shares selectedIndex with the track panel)
+	bookmarks []Bookmark
 }
 
 // NewPlaybackOverlay creates a new overlay for the given player.
-// screenW/screenH are used to scale font sizes proportionally to resolution.
-func NewPlaybackOverlay(p *Player, screenW, screenH int) *PlaybackOverlay {
+// screenW/screenH are used to scale font sizes proportionally to resolution;
+// cfg supplies the auto-hide delay and the always-on progress line setting.
+func NewPlaybackOverlay(p *Player, screenW, screenH int, cfg config.PlaybackConfig) *PlaybackOverlay {
 	if screenW <= 0 {
 		screenW = 1920
 	}
 	if screenH <= 0 {
 		screenH = 1080
 	}
+	hideDelay := defaultHideDelay
+	if cfg.OsdHideSeconds > 0 {
+		hideDelay = time.Duration(cfg.OsdHideSeconds * float64(time.Second))
+	}
+	buttons := parseButtons(cfg.OverlayButtons)
+	focused := BtnPlayPause
+	if !slices.Contains(buttons, focused) {
+		focused = buttons[0]
+		if focused == BtnNext {
+			focused = buttons[1]
+		}
+	}
 	return &PlaybackOverlay{
-		player:     p,
-		Mode:       OverlayHidden,
-		hideDelay:  4 * time.Second,
-		focusedBtn: BtnPlayPause,
-		screenW:    screenW,
-		screenH:    screenH,
+		player:       p,
+		Mode:         OverlayHidden,
+		hideDelay:    hideDelay,
+		buttons:      buttons,
+		focusedBtn:   focused,
+		screenW:      screenW,
+		screenH:      screenH,
+		progressLine: cfg.ProgressLine,
+		showClock:    cfg.ShowClock,
+		showBattery:  cfg.ShowBattery,
 	}
 }
 
@@ -152,17 +274,17 @@ func (o *PlaybackOverlay) barWidth() int {
 	return w
 }
 
-// visibleButtons returns the set of buttons that should be shown, filtering
-// out BtnNext when showNextBtn is false.
+// visibleButtons returns the configured buttons that should be shown,
+// filtering out BtnNext when showNextBtn is false.
 func (o *PlaybackOverlay) visibleButtons() []ControlButton {
-	all := []ControlButton{
-		BtnSeekBack60, BtnSeekBack10, BtnPlayPause, BtnStop,
-		BtnSeekFwd10, BtnSeekFwd60, BtnSubtitles, BtnAudio,
-	}
-	if o.showNextBtn {
-		all = append(all, BtnNext)
+	vis := make([]ControlButton, 0, len(o.buttons))
+	for _, btn := range o.buttons {
+		if btn == BtnNext && !o.showNextBtn {
+			continue
+		}
+		vis = append(vis, btn)
 	}
-	return all
+	return vis
 }
 
 // visibleIndex returns the index of focusedBtn in visibleButtons, or 0.
@@ -179,7 +301,7 @@ func (o *PlaybackOverlay) visibleIndex() int {
 func (o *PlaybackOverlay) Show() {
 	// Remove paused bar (full bar replaces it), but keep clock
 	o.player.OsdOverlayRemove(osdIDPausedBar)
-	if o.player.Paused() {
+	if o.player.Paused() || o.showClock {
 		o.renderClock()
 	} else {
 		o.hidePausedOsd()
@@ -222,7 +344,7 @@ func (o *PlaybackOverlay) Update() {
 		// Periodically re-render to keep progress bar current
 		if time.Since(o.lastRender) > time.Second {
 			o.renderBar()
-			if paused {
+			if paused || o.showClock {
 				o.renderClock()
 			}
 		}
@@ -235,11 +357,24 @@ func (o *PlaybackOverlay) Update() {
 		}
 	}
 
-	// Not paused: ensure persistent overlays are removed
-	if !paused && o.pausedOsdShown {
+	// Not paused: ensure persistent overlays are removed (the clock stays
+	// with the bar when showClock is set)
+	if !paused && o.pausedOsdShown && !(o.showClock && o.Mode == OverlayBar) {
 		o.hidePausedOsd()
 	}
 
+	// Always-on progress line: only while playing with the full bar closed
+	// (the bar and the paused info have their own progress display)
+	if o.progressLine {
+		if !paused && o.Mode != OverlayBar {
+			if time.Since(o.lastLineRender) > time.Second {
+				o.renderProgressLine()
+			}
+		} else if o.progressLineShown {
+			o.hideProgressLine()
+		}
+	}
+
 	// Check if we should activate the next-up banner
 	if o.nextUpName != "" && !o.nextUpActive {
 		pos := o.player.Position()
@@ -264,4 +399,5 @@ func (o *PlaybackOverlay) Update() {
 // Cleanup removes all persistent overlays. Call before discarding the overlay.
 func (o *PlaybackOverlay) Cleanup() {
 	o.hidePausedOsd()
+	o.hideProgressLine()
 }
@@ -98,7 +98,7 @@ func (o *PlaybackOverlay) seekWithAcceleration(dir Direction) {
 		o.accel.stepIndex = 0
 	} else {
 		// Same direction within 1s: escalate
-		if o.accel.stepIndex < len(seekSteps)-1 {
+		if o.accel.stepIndex < len(o.player.Seeks.Accel)-1 {
 			o.accel.stepIndex++
 		}
 	}
@@ -106,7 +106,7 @@ func (o *PlaybackOverlay) seekWithAcceleration(dir Direction) {
 	o.accel.lastDir = dir
 	o.accel.lastPress = now
 
-	amount := seekSteps[o.accel.stepIndex]
+	amount := o.player.Seeks.Accel[o.accel.stepIndex]
 	if dir == DirLeft {
 		amount = -amount
 	}
@@ -118,19 +118,19 @@ func (o *PlaybackOverlay) seekWithAcceleration(dir Direction) {
 func (o *PlaybackOverlay) activateButton() {
 	switch o.focusedBtn {
 	case BtnSeekBack60:
-		o.player.Seek(-SeekLarge)
+		o.player.Seek(-o.player.Seeks.Large)
 		o.renderBar()
 	case BtnSeekBack10:
-		o.player.Seek(-SeekSmall)
+		o.player.Seek(-o.player.Seeks.Small)
 		o.renderBar()
 	case BtnPlayPause:
 		o.player.TogglePause()
 		o.renderBar()
 	case BtnSeekFwd10:
-		o.player.Seek(SeekSmall)
+		o.player.Seek(o.player.Seeks.Small)
 		o.renderBar()
 	case BtnSeekFwd60:
-		o.player.Seek(SeekLarge)
+		o.player.Seek(o.player.Seeks.Large)
 		o.renderBar()
 	case BtnSubtitles:
 		o.OpenTrackPanel(TrackSub)
@@ -227,8 +227,11 @@ func (o *PlaybackOverlay) renderBar() {
 	// Next episode tooltip line (above progress bar)
 	if nextFocused {
 		if epInfo != nil {
-			tooltip := fmt.Sprintf("Up Next: S%dE%d \u00B7 %s",
-				epInfo.SeasonNumber, epInfo.EpisodeNumber, epInfo.Title)
+			tooltip := "Up Next: " + epInfo.Title
+			if epInfo.EpisodeNumber > 0 {
+				tooltip = fmt.Sprintf("Up Next: S%dE%d \u00B7 %s",
+					epInfo.SeasonNumber, epInfo.EpisodeNumber, epInfo.Title)
+			}
 			b.WriteString(fmt.Sprintf("{\\fs%d\\bord1%s}", o.scale(13), assColorWhite))
 			b.WriteString(tooltip + "\\N")
 		} else if noNext {
@@ -0,0 +1,132 @@
+package player
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// OpenBookmarkPanel lists the bookmarks for the playing item.
+func (o *PlaybackOverlay) OpenBookmarkPanel() {
+	if o.Bookmarks == nil || o.player.ItemID() == "" {
+		return
+	}
+	o.bookmarks = o.Bookmarks.List(o.player.ItemID())
+	o.selectedIndex = 0
+
+	// Pre-focus the last bookmark before the current position
+	pos := o.player.Position()
+	for i, bm := range o.bookmarks {
+		if bm.Seconds <= pos {
+			o.selectedIndex = i
+		}
+	}
+
+	o.Mode = OverlayBookmarks
+	o.lastInput = time.Now()
+	o.renderBookmarkPanel()
+}
+
+// AddBookmark stores a bookmark at the current position and briefly confirms it.
+func (o *PlaybackOverlay) AddBookmark() {
+	itemID := o.player.ItemID()
+	if o.Bookmarks == nil || itemID == "" {
+		return
+	}
+	bm, err := o.Bookmarks.Add(itemID, "", o.player.Position())
+	if err != nil {
+		log.Printf("Failed to save bookmark: %v", err)
+	}
+	if o.Mode == OverlayBookmarks {
+		o.bookmarks = o.Bookmarks.List(itemID)
+		o.renderBookmarkPanel()
+		return
+	}
+	o.player.ShowText(fmt.Sprintf("Added %s at %s", bm.Name, FormatDuration(bm.Seconds)), 2000)
+}
+
+// HandleBookmarkInput handles input when the bookmark panel is open.
+// Enter jumps to the focused bookmark, del removes it. Returns true if input was consumed.
+func (o *PlaybackOverlay) HandleBookmarkInput(dir Direction, enter, back, del bool) bool {
+	o.lastInput = time.Now()
+
+	if back {
+		o.Mode = OverlayBar
+		o.renderBar()
+		return true
+	}
+
+	if dir == DirUp {
+		if o.selectedIndex > 0 {
+			o.selectedIndex--
+		}
+		o.renderBookmarkPanel()
+		return true
+	}
+
+	if dir == DirDown {
+		if o.selectedIndex < len(o.bookmarks)-1 {
+			o.selectedIndex++
+		}
+		o.renderBookmarkPanel()
+		return true
+	}
+
+	if del && o.selectedIndex < len(o.bookmarks) {
+		itemID := o.player.ItemID()
+		if err := o.Bookmarks.Remove(itemID, o.selectedIndex); err != nil {
+			log.Printf("Failed to save bookmarks: %v", err)
+		}
+		o.bookmarks = o.Bookmarks.List(itemID)
+		if o.selectedIndex >= len(o.bookmarks) && o.selectedIndex > 0 {
+			o.selectedIndex--
+		}
+		o.renderBookmarkPanel()
+		return true
+	}
+
+	if enter && o.selectedIndex < len(o.bookmarks) {
+		o.player.SeekAbsolute(o.bookmarks[o.selectedIndex].Seconds)
+		o.Mode = OverlayBar
+		o.renderBar()
+		return true
+	}
+
+	// Consume all other input while modal is open
+	return true
+}
+
+// renderBookmarkPanel renders the bookmark list ASS in the track panel style.
+func (o *PlaybackOverlay) renderBookmarkPanel() {
+	var b strings.Builder
+
+	b.WriteString("${osd-ass-cc/0}")
+	b.WriteString("{\\an5\\bord0\\shad0}")
+	b.WriteString(fmt.Sprintf("{\\fs%d\\bord1%s}", o.scale(15), assColorBlue) + "Bookmarks\\N\\N")
+
+	if len(o.bookmarks) == 0 {
+		b.WriteString(fmt.Sprintf("{\\fs%d\\bord1%s}", o.scale(13), assColorGray))
+		b.WriteString("No bookmarks yet\\N")
+	}
+
+	for i, bm := range o.bookmarks {
+		label := FormatDuration(bm.Seconds) + "  " + bm.Name
+
+		b.WriteString(fmt.Sprintf("{\\fs%d\\bord1}", o.scale(13)))
+		if i == o.selectedIndex {
+			b.WriteString("{" + assColorBlue + "\\b1}")
+			b.WriteString("\u25B8 " + label)
+			b.WriteString("{\\b0}")
+		} else {
+			b.WriteString("{" + assColorGray + "}")
+			b.WriteString("   " + label)
+		}
+		b.WriteString("\\N")
+	}
+
+	b.WriteString(fmt.Sprintf("\\N{\\fs%d\\bord1%s}", o.scale(10), assColorDimGray))
+	b.WriteString("Enter: jump   Del: remove   Esc: close")
+
+	o.player.ShowText(b.String(), 30000)
+}
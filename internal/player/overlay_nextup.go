@@ -76,7 +76,11 @@ func (o *PlaybackOverlay) renderNextUp() {
 	b.WriteString("Up Next\\N")
 
 	b.WriteString(fmt.Sprintf("{\\fs%d\\bord1%s\\b1}", o.scale(15), assColorWhite))
-	b.WriteString(fmt.Sprintf("Episode %d starting in %ds...{\\b0}\\N", o.nextUpIndex, remaining))
+	label := fmt.Sprintf("Episode %d", o.nextUpIndex)
+	if o.nextUpIndex == 0 {
+		label = o.nextUpName // queued item without an episode number
+	}
+	b.WriteString(fmt.Sprintf("%s starting in %ds...{\\b0}\\N", label, remaining))
 
 	b.WriteString(fmt.Sprintf("{\\fs%d\\bord1%s\\b1}", o.scale(13), assColorBlue))
 	b.WriteString("[ Start ]")
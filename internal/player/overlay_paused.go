@@ -16,21 +16,57 @@ func (o *PlaybackOverlay) renderPausedInfo() {
 	// Bottom bar: progress + time/duration
 	ass := fmt.Sprintf("{\\an2\\bord2\\fs%d%s}%s\\N{\\fs%d%s}%s / %s",
 		o.scale(9), assColorGray, o.buildProgressBar(o.barWidth()),
-		o.scale(11), assColorWhite, formatDuration(pos), formatDuration(dur))
+		o.scale(11), assColorWhite, FormatDuration(pos), FormatDuration(dur))
 	o.player.OsdOverlay(osdIDPausedBar, ass, o.screenW, o.screenH)
 
 	o.renderClock()
 	o.pausedOsdShown = true
 }
 
-// renderClock renders only the top-right wall clock overlay.
+// renderClock renders only the top-right wall clock overlay, followed by the
+// battery level when showBattery is set and a battery is present.
 func (o *PlaybackOverlay) renderClock() {
 	clock := time.Now().Format("15:04")
+	if o.FormatClock != nil {
+		clock = o.FormatClock(time.Now())
+	}
+	if o.showBattery {
+		if bat := o.batteryText(); bat != "" {
+			clock += "  " + bat
+		}
+	}
 	ass := fmt.Sprintf("{\\an9\\bord2\\fs%d%s}%s", o.scale(14), assColorWhite, clock)
 	o.player.OsdOverlay(osdIDClock, ass, o.screenW, o.screenH)
 	o.pausedOsdShown = true
 }
 
+// batteryStatus caches the last battery reading so sysfs/Win32 isn't queried
+// on every once-a-second re-render.
+type batteryStatus struct {
+	read     time.Time
+	percent  int
+	charging bool
+	ok       bool
+}
+
+// batteryPollInterval is how often the battery level is re-read.
+const batteryPollInterval = 30 * time.Second
+
+// batteryText returns e.g. "85%" or "85% charging", or "" without a battery.
+func (o *PlaybackOverlay) batteryText() string {
+	if time.Since(o.battery.read) > batteryPollInterval {
+		o.battery.percent, o.battery.charging, o.battery.ok = readBattery()
+		o.battery.read = time.Now()
+	}
+	if !o.battery.ok {
+		return ""
+	}
+	if o.battery.charging {
+		return fmt.Sprintf("%d%% charging", o.battery.percent)
+	}
+	return fmt.Sprintf("%d%%", o.battery.percent)
+}
+
 // hidePausedOsd removes both persistent paused overlays.
 func (o *PlaybackOverlay) hidePausedOsd() {
 	o.player.OsdOverlayRemove(osdIDClock)
@@ -38,8 +74,51 @@ func (o *PlaybackOverlay) hidePausedOsd() {
 	o.pausedOsdShown = false
 }
 
-// formatDuration formats seconds into "H:MM:SS" or "MM:SS".
-func formatDuration(seconds float64) string {
+// renderProgressLine draws a thin full-width progress line along the bottom
+// edge as a persistent OSD overlay: elapsed in blue over a dim track.
+func (o *PlaybackOverlay) renderProgressLine() {
+	o.lastLineRender = time.Now()
+
+	dur := o.player.Duration()
+	if dur <= 0 {
+		return
+	}
+	frac := o.player.Position() / dur
+	if frac < 0 {
+		frac = 0
+	} else if frac > 1 {
+		frac = 1
+	}
+
+	h := o.screenH / 270 // 4px at 1080p
+	if h < 2 {
+		h = 2
+	}
+	y := o.screenH - h
+	filled := int(float64(o.screenW) * frac)
+
+	rect := func(color, alpha string, w int) string {
+		return fmt.Sprintf("{\\an7\\pos(0,%d)\\bord0\\shad0%s\\1a&H%s&\\p1}m 0 0 l %d 0 %d %d 0 %d{\\p0}",
+			y, color, alpha, w, w, h, h)
+	}
+	ass := rect(assColorBarBg, "60", o.screenW)
+	if filled > 0 {
+		ass += "\n" + rect(assColorBlue, "00", filled)
+	}
+	o.player.OsdOverlay(osdIDProgress, ass, o.screenW, o.screenH)
+	o.progressLineShown = true
+}
+
+// hideProgressLine removes the always-on progress line.
+func (o *PlaybackOverlay) hideProgressLine() {
+	if o.progressLineShown {
+		o.player.OsdOverlayRemove(osdIDProgress)
+		o.progressLineShown = false
+	}
+}
+
+// FormatDuration formats seconds into "H:MM:SS" or "MM:SS".
+func FormatDuration(seconds float64) string {
 	if seconds < 0 {
 		seconds = 0
 	}
@@ -0,0 +1,75 @@
+package player
+
+import "sync"
+
+// QueueItem is a single entry in a play queue.
+type QueueItem struct {
+	ID   string
+	Name string
+}
+
+// Queue is an ordered list of items played back to back. The current entry
+// advances as each item is started; a nil *Queue behaves as an empty queue.
+type Queue struct {
+	mu    sync.Mutex
+	items []QueueItem
+	index int
+}
+
+// NewQueue creates a queue positioned at its first item.
+func NewQueue(items []QueueItem) *Queue {
+	return &Queue{items: items}
+}
+
+// Len returns the number of items in the queue.
+func (q *Queue) Len() int {
+	if q == nil {
+		return 0
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// Current returns the item at the queue position.
+func (q *Queue) Current() (QueueItem, bool) {
+	if q == nil {
+		return QueueItem{}, false
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.index >= len(q.items) {
+		return QueueItem{}, false
+	}
+	return q.items[q.index], true
+}
+
+// Peek returns the item after the current one without advancing.
+func (q *Queue) Peek() (QueueItem, bool) {
+	if q == nil {
+		return QueueItem{}, false
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.index+1 >= len(q.items) {
+		return QueueItem{}, false
+	}
+	return q.items[q.index+1], true
+}
+
+// Jump moves the queue position to the first entry with id at or after the
+// current position. Returns false if id is not queued there.
+func (q *Queue) Jump(id string) bool {
+	if q == nil {
+		return false
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i := q.index; i < len(q.items); i++ {
+		if q.items[i].ID == id {
+			q.index = i
+			return true
+		}
+	}
+	return false
+}
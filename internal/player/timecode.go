@@ -0,0 +1,38 @@
+package player
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseTimecode converts "hh:mm:ss", "mm:ss" or "ss" (seconds may carry a
+// fractional part, e.g. "01:02:03.250") into seconds.
+func ParseTimecode(tc string) (float64, error) {
+	tc = strings.TrimSpace(tc)
+	if tc == "" {
+		return 0, fmt.Errorf("empty timecode")
+	}
+	parts := strings.Split(tc, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timecode %q", tc)
+	}
+	var total float64
+	for i, part := range parts {
+		last := i == len(parts)-1
+		var v float64
+		var err error
+		if last {
+			v, err = strconv.ParseFloat(part, 64)
+		} else {
+			var n int
+			n, err = strconv.Atoi(part)
+			v = float64(n)
+		}
+		if err != nil || v < 0 {
+			return 0, fmt.Errorf("invalid timecode %q", tc)
+		}
+		total = total*60 + v
+	}
+	return total, nil
+}
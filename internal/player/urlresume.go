@@ -0,0 +1,64 @@
+package player
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// URLResumeStore remembers where videos played by URL (trailers and the
+// like, which have no server-side resume point) were stopped. Entries are
+// keyed by a hash of the URL, so the file holds no links.
+type URLResumeStore struct {
+	path      string
+	mu        sync.Mutex
+	positions map[string]float64 // URL hash -> seconds
+}
+
+// LoadURLResume reads the resume file at path. A missing file yields an empty store.
+func LoadURLResume(path string) (*URLResumeStore, error) {
+	rs := &URLResumeStore{path: path, positions: make(map[string]float64)}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return rs, nil
+		}
+		return rs, err
+	}
+	if err := json.Unmarshal(data, &rs.positions); err != nil {
+		return rs, fmt.Errorf("parse URL resume positions: %w", err)
+	}
+	return rs, nil
+}
+
+func urlKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:16])
+}
+
+// Position returns where url was stopped, or 0.
+func (rs *URLResumeStore) Position(url string) float64 {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.positions[urlKey(url)]
+}
+
+// Set stores the position of url, or forgets it when seconds is 0, and
+// saves the file.
+func (rs *URLResumeStore) Set(url string, seconds float64) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	key := urlKey(url)
+	if seconds <= 0 {
+		if _, ok := rs.positions[key]; !ok {
+			return nil
+		}
+		delete(rs.positions, key)
+	} else {
+		rs.positions[key] = seconds
+	}
+	return saveJSON(rs.path, rs.positions)
+}
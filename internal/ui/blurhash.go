@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/depeter/jellycouch/internal/blurhash"
+)
+
+// Decoded previews are tiny; drawing them scaled with linear filtering
+// smooths them out.
+const (
+	blurHashW = 20
+	blurHashH = 30
+	// blurHashCacheSize bounds blurHashes; past it the cache starts over.
+	blurHashCacheSize = 512
+)
+
+// blurHashes caches decoded previews by hash; nil marks an invalid hash.
+// Only used from Draw.
+var blurHashes = map[string]*ebiten.Image{}
+
+// blurHashImage returns the decoded preview for hash, or nil if there is
+// none.
+func blurHashImage(hash string) *ebiten.Image {
+	if hash == "" {
+		return nil
+	}
+	if img, ok := blurHashes[hash]; ok {
+		return img
+	}
+	if len(blurHashes) >= blurHashCacheSize {
+		clear(blurHashes)
+	}
+	var img *ebiten.Image
+	if rgba, err := blurhash.Decode(hash, blurHashW, blurHashH); err == nil {
+		img = ebiten.NewImageFromImage(rgba)
+	}
+	blurHashes[hash] = img
+	return img
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ConfirmExit asks before Back on the last screen closes the app
+// (ui.confirm_exit).
+var ConfirmExit = true
+
+// ConfirmDialog is a modal yes/no question drawn above every screen, for
+// actions that are hard to undo. Cancel has focus first, so an OK pressed
+// by accident does nothing; Back cancels too.
+type ConfirmDialog struct {
+	Title        string
+	Message      string // wrapped
+	ConfirmLabel string // e.g. "Exit"; the action, not "Yes"
+	CancelLabel  string // "" = Cancel
+	OnConfirm    func() // called without any screen lock held
+	OnCancel     func() // optional; the Cancel button only, not Back
+
+	focused     int // 0 = Cancel, 1 = confirm
+	buttonRects [2]ButtonRect
+}
+
+// Update handles input and reports whether the dialog should close, and
+// which button closed it: 1 for confirm, 0 for Cancel, -1 for Back.
+func (cd *ConfirmDialog) Update() (done bool, button int) {
+	dir, enter, back := InputState()
+	if back {
+		return true, -1
+	}
+	if mx, my, clicked := MouseJustClicked(); clicked {
+		for i, r := range cd.buttonRects {
+			if r.Hit(mx, my) {
+				return true, i
+			}
+		}
+		return false, 0
+	}
+	switch dir {
+	case DirLeft:
+		cd.focused = 0
+	case DirRight:
+		cd.focused = 1
+	}
+	if enter {
+		return true, cd.focused
+	}
+	return false, 0
+}
+
+func (cd *ConfirmDialog) Draw(dst *ebiten.Image) {
+	vector.DrawFilledRect(dst, 0, 0, ScreenWidth, ScreenHeight, ColorOverlay, false)
+
+	textW := messageDialogW - messageDialogPad*2
+	h := messageDialogPad + FontSizeHeading + 20 +
+		MeasureTextWrapped(cd.Message, textW, FontSizeBody) + 16 +
+		messageDialogBtnH + messageDialogPad
+
+	x := (float64(ScreenWidth) - messageDialogW) / 2
+	y := (float64(ScreenHeight) - h) / 2
+	vector.DrawFilledRect(dst, float32(x), float32(y), messageDialogW, float32(h), ColorBackground, false)
+	vector.StrokeRect(dst, float32(x), float32(y), messageDialogW, float32(h), 2, ColorPrimary, false)
+
+	tx := x + messageDialogPad
+	ty := y + messageDialogPad
+	DrawText(dst, cd.Title, tx, ty, FontSizeHeading, ColorText)
+	ty += FontSizeHeading + 20
+	ty += DrawTextWrapped(dst, cd.Message, tx, ty, textW, FontSizeBody, ColorTextSecondary) + 16
+
+	// Cancel, then the action at the right edge
+	cancel := cd.CancelLabel
+	if cancel == "" {
+		cancel = T("common.cancel")
+	}
+	labels := [2]string{cancel, cd.ConfirmLabel}
+	bx := x + messageDialogW - messageDialogPad
+	for i := len(labels) - 1; i >= 0; i-- {
+		tw, _ := MeasureText(labels[i], FontSizeBody)
+		w := max(messageDialogBtnW, tw+40)
+		bx -= w
+		cd.buttonRects[i] = ButtonRect{X: bx, Y: ty, W: w, H: messageDialogBtnH}
+		bg, fg := ColorSurface, ColorTextSecondary
+		if i == cd.focused {
+			bg, fg = ColorPrimary, ColorBackground
+		}
+		vector.DrawFilledRect(dst, float32(bx), float32(ty), float32(w), messageDialogBtnH, bg, false)
+		DrawTextCentered(dst, labels[i], bx+w/2, ty+messageDialogBtnH/2, FontSizeBody, fg)
+		bx -= 12
+	}
+}
@@ -0,0 +1,504 @@
+package ui
+
+import (
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/depeter/jellycouch/internal/jellyfin"
+)
+
+// ContextAction identifies an entry in a grid item's context menu.
+type ContextAction int
+
+const (
+	ActionPlay ContextAction = iota
+	ActionToggleWatched
+	ActionToggleFavorite
+	ActionRemoveFromResume
+	ActionGoToSeries
+	ActionRequest4K
+	ActionAddToPlaylist
+	ActionMarkWatchedUpTo       // earlier episodes of the season
+	ActionMarkSeriesWatchedUpTo // earlier episodes of the series
+	ActionRefreshMetadata
+	ActionShowPerson // a cast or crew member's filmography
+)
+
+const (
+	contextMenuW    = 280
+	contextMenuRowH = 40
+	contextMenuPad  = 8
+)
+
+type contextMenuEntry struct {
+	Label  string
+	Action ContextAction
+	Target string // playlist or person ID for ActionAddToPlaylist and ActionShowPerson ("" opens their list)
+}
+
+// ContextMenuOptions enables entries that depend on where the menu was opened.
+type ContextMenuOptions struct {
+	InResume   bool                 // item is in the Continue Watching row
+	InSeason   bool                 // item is in a series' episode list
+	CanRequest bool                 // Jellyseerr is configured
+	CanRefresh bool                 // the user is a server administrator
+	Playlists  []jellyfin.MediaItem // offered by "Add to Playlist" (none hides it)
+	People     []jellyfin.Person    // offered by "Cast & Crew" (none hides it)
+}
+
+// contextMenuMaxPeople is how many people the "Cast & Crew" submenu lists,
+// so it fits on screen.
+const contextMenuMaxPeople = 12
+
+// ContextMenu is a small popup listing actions for a single grid item.
+type ContextMenu struct {
+	item    GridItem
+	entries []contextMenuEntry
+	index   int
+	x, y    float64
+	rects   []ButtonRect
+
+	playlists []contextMenuEntry // "Add to Playlist" submenu
+	people    []contextMenuEntry // "Cast & Crew" submenu
+	main      []contextMenuEntry // top-level entries while a submenu is shown
+
+	done   bool
+	chosen bool
+}
+
+// isPlayableType reports whether items of the given type can be played directly.
+func isPlayableType(itemType string) bool {
+	switch itemType {
+	case "Movie", "Episode", "Video", "MusicVideo", "Audio":
+		return true
+	}
+	return false
+}
+
+// contextMenuEntries returns the actions offered for an item based on its type.
+func contextMenuEntries(item GridItem, opts ContextMenuOptions) []contextMenuEntry {
+	var entries []contextMenuEntry
+	if isPlayableType(item.Type) {
+		label := "Play"
+		if item.Progress > 0 {
+			label = "Resume"
+		}
+		entries = append(entries, contextMenuEntry{Label: label, Action: ActionPlay})
+	}
+	switch item.Type {
+	case "Movie", "Episode", "Series", "Season", "Video", "MusicVideo":
+		label := "Mark Watched"
+		if item.Watched {
+			label = "Mark Unwatched"
+		}
+		entries = append(entries, contextMenuEntry{Label: label, Action: ActionToggleWatched})
+	}
+	if item.Type != "" {
+		label := "Add to Favorites"
+		if item.Favorite {
+			label = "Remove from Favorites"
+		}
+		entries = append(entries, contextMenuEntry{Label: label, Action: ActionToggleFavorite})
+	}
+	if opts.InResume {
+		entries = append(entries, contextMenuEntry{Label: "Remove from Continue Watching", Action: ActionRemoveFromResume})
+	}
+	if opts.InSeason && item.Type == "Episode" {
+		entries = append(entries,
+			contextMenuEntry{Label: "Mark Season Watched to Here", Action: ActionMarkWatchedUpTo},
+			contextMenuEntry{Label: "Mark Series Watched to Here", Action: ActionMarkSeriesWatchedUpTo})
+	}
+	if (item.Type == "Episode" || item.Type == "Season") && item.SeriesID != "" {
+		entries = append(entries, contextMenuEntry{Label: "Go to Series", Action: ActionGoToSeries})
+	}
+	if opts.CanRequest && item.TMDBID != "" && (item.Type == "Movie" || item.Type == "Series") {
+		entries = append(entries, contextMenuEntry{Label: "Request 4K", Action: ActionRequest4K})
+	}
+	if len(opts.People) > 0 {
+		entries = append(entries, contextMenuEntry{Label: "Cast & Crew...", Action: ActionShowPerson})
+	}
+	if len(opts.Playlists) > 0 && item.Type != "Playlist" && item.Type != "" {
+		entries = append(entries, contextMenuEntry{Label: "Add to Playlist...", Action: ActionAddToPlaylist})
+	}
+	if opts.CanRefresh && item.Type != "" {
+		entries = append(entries, contextMenuEntry{Label: "Refresh Metadata", Action: ActionRefreshMetadata})
+	}
+	return entries
+}
+
+// NewContextMenu creates a menu for item anchored at (x, y).
+// Returns nil if the item has no applicable actions.
+func NewContextMenu(item GridItem, x, y float64, opts ContextMenuOptions) *ContextMenu {
+	entries := contextMenuEntries(item, opts)
+	if len(entries) == 0 {
+		return nil
+	}
+	cm := &ContextMenu{item: item, entries: entries, x: x, y: y}
+	for _, pl := range opts.Playlists {
+		cm.playlists = append(cm.playlists, contextMenuEntry{Label: pl.Name, Action: ActionAddToPlaylist, Target: pl.ID})
+	}
+	for _, p := range opts.People[:min(len(opts.People), contextMenuMaxPeople)] {
+		label := p.Name
+		if p.Role != "" {
+			label += " as " + p.Role
+		} else if p.Type != "" && p.Type != "Actor" {
+			label += " (" + p.Type + ")"
+		}
+		cm.people = append(cm.people, contextMenuEntry{Label: label, Action: ActionShowPerson, Target: p.ID})
+	}
+	cm.clampToScreen()
+	return cm
+}
+
+// NewPlaylistMenu creates a menu listing playlists, for adding several items
+// at once. Returns nil if there are none.
+func NewPlaylistMenu(playlists []jellyfin.MediaItem, x, y float64) *ContextMenu {
+	if len(playlists) == 0 {
+		return nil
+	}
+	cm := &ContextMenu{x: x, y: y}
+	for _, pl := range playlists {
+		cm.entries = append(cm.entries, contextMenuEntry{Label: pl.Name, Action: ActionAddToPlaylist, Target: pl.ID})
+	}
+	cm.clampToScreen()
+	return cm
+}
+
+// clampToScreen keeps the panel on screen for the current entries.
+func (cm *ContextMenu) clampToScreen() {
+	h := float64(len(cm.entries)*contextMenuRowH + contextMenuPad*2)
+	if cm.x+contextMenuW > ScreenWidth-SectionPadding {
+		cm.x = ScreenWidth - SectionPadding - contextMenuW
+	}
+	if cm.y+h > ScreenHeight-SectionPadding {
+		cm.y = ScreenHeight - SectionPadding - h
+	}
+	if cm.y < SectionPadding {
+		cm.y = SectionPadding
+	}
+}
+
+// choose selects entry i, opening the playlist or people submenu instead of
+// closing when it is the "Add to Playlist" or "Cast & Crew" entry.
+func (cm *ContextMenu) choose(i int) {
+	cm.index = i
+	if e := cm.entries[i]; e.Target == "" && (e.Action == ActionAddToPlaylist || e.Action == ActionShowPerson) {
+		cm.main = cm.entries
+		cm.entries = cm.playlists
+		if e.Action == ActionShowPerson {
+			cm.entries = cm.people
+		}
+		cm.index = 0
+		cm.clampToScreen()
+		return
+	}
+	cm.chosen = true
+	cm.done = true
+}
+
+// ContextMenuKeyPressed reports whether the context menu key (Menu, Shift+F10
+// or a remote's Menu button) was pressed.
+func ContextMenuKeyPressed() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyContextMenu) ||
+		(inpututil.IsKeyJustPressed(ebiten.KeyF10) && ebiten.IsKeyPressed(ebiten.KeyShift)) ||
+		RemoteJustPressed(RemoteMenu)
+}
+
+// RightClickAction is what right-clicking a poster or episode does.
+type RightClickAction int
+
+const (
+	RightClickMenu    RightClickAction = iota // open the context menu
+	RightClickWatched                         // toggle watched straight away
+	RightClickNone                            // nothing, for remotes that emulate a mouse
+)
+
+// PosterRightClick is set from ui.right_click_action at startup.
+var PosterRightClick = RightClickMenu
+
+// ParseRightClickAction maps a ui.right_click_action value ("context-menu",
+// "watched" or "none") to a RightClickAction, defaulting to RightClickMenu.
+func ParseRightClickAction(s string) RightClickAction {
+	switch s {
+	case "watched":
+		return RightClickWatched
+	case "none":
+		return RightClickNone
+	}
+	return RightClickMenu
+}
+
+// Item returns the grid item the menu was opened for.
+func (cm *ContextMenu) Item() GridItem {
+	return cm.item
+}
+
+// Target returns the playlist or person ID chosen for ActionAddToPlaylist or
+// ActionShowPerson.
+func (cm *ContextMenu) Target() string {
+	if !cm.chosen {
+		return ""
+	}
+	return cm.entries[cm.index].Target
+}
+
+// Done returns true once the menu should close, along with the chosen action.
+// ok is false when the menu was dismissed without choosing.
+func (cm *ContextMenu) Done() (done bool, action ContextAction, ok bool) {
+	if !cm.done {
+		return false, 0, false
+	}
+	if !cm.chosen {
+		return true, 0, false
+	}
+	return true, cm.entries[cm.index].Action, true
+}
+
+func (cm *ContextMenu) Update() {
+	mx, my, clicked := MouseJustClicked()
+	_, _, rclicked := MouseJustRightClicked()
+	if clicked || rclicked {
+		for i, r := range cm.rects {
+			if PointInRect(mx, my, r.X, r.Y, r.W, r.H) {
+				if clicked {
+					cm.choose(i)
+				} else {
+					cm.done = true
+				}
+				return
+			}
+		}
+		// Click outside dismisses
+		cm.done = true
+		return
+	}
+
+	dir, enter, back := InputState()
+	if back && cm.main != nil {
+		// Leave the submenu, back on the entry that opened it
+		back := cm.entries[0].Action
+		cm.entries = cm.main
+		cm.main = nil
+		cm.index = len(cm.entries) - 1
+		for i, e := range cm.entries {
+			if e.Action == back {
+				cm.index = i
+			}
+		}
+		cm.clampToScreen()
+		return
+	}
+	if back || ContextMenuKeyPressed() {
+		cm.done = true
+		return
+	}
+	switch dir {
+	case DirUp:
+		if cm.index > 0 {
+			cm.index--
+		}
+	case DirDown:
+		if cm.index < len(cm.entries)-1 {
+			cm.index++
+		}
+	}
+	if enter {
+		cm.choose(cm.index)
+	}
+}
+
+func (cm *ContextMenu) Draw(dst *ebiten.Image) {
+	h := float32(len(cm.entries)*contextMenuRowH + contextMenuPad*2)
+	px, py := float32(cm.x), float32(cm.y)
+
+	vector.DrawFilledRect(dst, px, py, contextMenuW, h, ColorSurface, false)
+	vector.StrokeRect(dst, px, py, contextMenuW, h, 2, ColorPrimary, false)
+
+	cm.rects = cm.rects[:0]
+	for i, e := range cm.entries {
+		ry := py + contextMenuPad + float32(i*contextMenuRowH)
+		rx := px + contextMenuPad
+		rw := float32(contextMenuW - contextMenuPad*2)
+		cm.rects = append(cm.rects, ButtonRect{X: float64(rx), Y: float64(ry), W: float64(rw), H: contextMenuRowH})
+
+		clr := ColorTextSecondary
+		if i == cm.index {
+			vector.DrawFilledRect(dst, rx, ry, rw, contextMenuRowH, ColorPrimary, false)
+			clr = ColorBackground
+		}
+		DrawText(dst, e.Label, float64(rx+12), float64(ry)+(contextMenuRowH-FontSizeBody)/2, FontSizeBody, clr)
+	}
+}
+
+// gridActionCallbacks are the navigation hooks used by runContextAction.
+type gridActionCallbacks struct {
+	OnItemSelected func(item jellyfin.MediaItem)
+	OnPlay         func(item jellyfin.MediaItem, resumeTicks int64)
+	OnRequest4K    func(tmdbID int, mediaType, title string)
+	OnPerson       func(personID string)
+}
+
+// runContextAction performs action on item, flipping local watched/favorite state.
+// target is the menu's Target() (the playlist for ActionAddToPlaylist, the
+// person for ActionShowPerson).
+// ActionRemoveFromResume and the mark-up-to actions are left to the caller
+// since they change the surrounding list.
+// The caller must hold any necessary mutex before calling this.
+func runContextAction(client *jellyfin.Client, item *GridItem, action ContextAction, target string, cb gridActionCallbacks) {
+	switch action {
+	case ActionPlay:
+		if cb.OnPlay == nil {
+			return
+		}
+		full, err := client.GetItem(item.ID)
+		if err != nil {
+			log.Printf("Failed to load %s for playback: %v", item.ID, err)
+			return
+		}
+		cb.OnPlay(*full, full.PlaybackPositionTicks)
+	case ActionToggleWatched:
+		item.Watched = ToggleWatched(client, item.ID, item.Watched)
+	case ActionToggleFavorite:
+		item.Favorite = ToggleFavorite(client, item.ID, item.Favorite)
+	case ActionGoToSeries:
+		if cb.OnItemSelected == nil {
+			return
+		}
+		series, err := client.GetItem(item.SeriesID)
+		if err != nil {
+			log.Printf("Failed to load series %s: %v", item.SeriesID, err)
+			return
+		}
+		cb.OnItemSelected(*series)
+	case ActionRequest4K:
+		if cb.OnRequest4K == nil {
+			return
+		}
+		tmdbID, err := strconv.Atoi(item.TMDBID)
+		if err != nil {
+			return
+		}
+		mediaType := "movie"
+		if item.Type == "Series" {
+			mediaType = "tv"
+		}
+		cb.OnRequest4K(tmdbID, mediaType, item.Title)
+	case ActionAddToPlaylist:
+		if target == "" {
+			return
+		}
+		itemID := item.ID
+		go func() {
+			if err := client.AddToPlaylist(target, itemID); err != nil {
+				log.Printf("Failed to add %s to playlist: %v", itemID, err)
+			}
+		}()
+	case ActionShowPerson:
+		if target != "" && cb.OnPerson != nil {
+			cb.OnPerson(target)
+		}
+	case ActionRefreshMetadata:
+		itemID, title := item.ID, item.Title
+		go func() {
+			if err := client.RefreshItem(itemID, jellyfin.RefreshFull); err != nil {
+				log.Printf("Failed to refresh %s: %v", itemID, err)
+				ShowToast("Could not refresh " + title)
+				return
+			}
+			ShowToast("Metadata refresh queued for " + title)
+		}()
+	}
+}
+
+// adminCache remembers whether the signed-in user is an administrator, for
+// the admin-only context menu entries.
+var adminCache struct {
+	mu      sync.Mutex
+	client  *jellyfin.Client // whose answer admin is
+	admin   bool
+	loading bool
+}
+
+// cachedIsAdmin reports whether the user of client is an administrator,
+// looking it up in the background the first time. Until it is known, it
+// returns false.
+func cachedIsAdmin(client *jellyfin.Client) bool {
+	adminCache.mu.Lock()
+	defer adminCache.mu.Unlock()
+	if client == nil {
+		return false
+	}
+	if adminCache.client != client && !adminCache.loading {
+		adminCache.loading = true
+		go func() {
+			lookupIsAdmin(client)
+			adminCache.mu.Lock()
+			adminCache.loading = false
+			adminCache.mu.Unlock()
+		}()
+	}
+	return adminCache.client == client && adminCache.admin
+}
+
+// lookupIsAdmin is cachedIsAdmin for background goroutines: it waits for the
+// server when the answer is not cached yet.
+func lookupIsAdmin(client *jellyfin.Client) bool {
+	adminCache.mu.Lock()
+	if adminCache.client == client {
+		defer adminCache.mu.Unlock()
+		return adminCache.admin
+	}
+	adminCache.mu.Unlock()
+
+	admin, err := client.IsAdministrator()
+	if err != nil {
+		log.Printf("Failed to load user policy: %v", err)
+		return false
+	}
+	adminCache.mu.Lock()
+	defer adminCache.mu.Unlock()
+	adminCache.client = client
+	adminCache.admin = admin
+	return admin
+}
+
+// playlistCacheTTL is how long the playlist list offered in context menus is reused.
+const playlistCacheTTL = time.Minute
+
+// playlistCache holds the user's playlists for the "Add to Playlist" submenu,
+// refreshed in the background so opening a menu never waits on the server.
+var playlistCache struct {
+	mu      sync.Mutex
+	items   []jellyfin.MediaItem
+	fetched time.Time
+	loading bool
+}
+
+// cachedPlaylists returns the last known playlists, starting a refresh if
+// they are missing or stale. The first call returns nil.
+func cachedPlaylists(client *jellyfin.Client) []jellyfin.MediaItem {
+	playlistCache.mu.Lock()
+	defer playlistCache.mu.Unlock()
+	if client != nil && !playlistCache.loading && time.Since(playlistCache.fetched) > playlistCacheTTL {
+		playlistCache.loading = true
+		go func() {
+			items, err := client.GetPlaylists()
+			playlistCache.mu.Lock()
+			defer playlistCache.mu.Unlock()
+			playlistCache.loading = false
+			playlistCache.fetched = time.Now()
+			if err != nil {
+				log.Printf("Failed to load playlists: %v", err)
+				return
+			}
+			playlistCache.items = items
+		}()
+	}
+	return playlistCache.items
+}
@@ -2,15 +2,28 @@ package ui
 
 import (
 	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/depeter/jellycouch/internal/cache"
+	"github.com/depeter/jellycouch/internal/netlog"
 )
 
 var debugOverlayVisible bool
 
+// debugNetLines is the number of recent HTTP requests listed.
+const debugNetLines = 14
+
+// ReadMemStats stops the world, so memory figures are sampled once a second.
+var (
+	debugMemStats  runtime.MemStats
+	debugMemSample time.Time
+)
+
 // ToggleDebugOverlay toggles the debug overlay on F12.
 func ToggleDebugOverlay() {
 	if inpututil.IsKeyJustPressed(ebiten.KeyF12) {
@@ -19,7 +32,7 @@ func ToggleDebugOverlay() {
 }
 
 // DrawDebugOverlay draws the debug overlay if visible.
-func DrawDebugOverlay(screen *ebiten.Image) {
+func DrawDebugOverlay(screen *ebiten.Image, imgCache *cache.ImageCache) {
 	if !debugOverlayVisible {
 		return
 	}
@@ -41,13 +54,21 @@ func DrawDebugOverlay(screen *ebiten.Image) {
 		}
 	}
 
+	if time.Since(debugMemSample) > time.Second {
+		runtime.ReadMemStats(&debugMemStats)
+		debugMemSample = time.Now()
+	}
+	requests := netlog.Recent(debugNetLines)
+
 	// Calculate overlay height
-	lines := 2 // header + separator
+	lines := 4 // header + FPS/memory + image cache + blank
+	lines += 1 + max(len(requests), 1)
+	lines += 2 // blank + separator
 	lines += max(len(evdevEvents), 1)
 	lines += 2 // blank + "Ebitengine keys:" header
 	lines += max(len(pressedKeys), 1)
 	panelH := float64(lines)*lineH + padY*2
-	panelW := 460.0
+	panelW := 640.0
 	px := float64(ScreenWidth) - panelW - marginR
 	py := marginT
 
@@ -57,9 +78,45 @@ func DrawDebugOverlay(screen *ebiten.Image) {
 	x := px + padX
 	y := py + padY
 
-	DrawText(screen, "Debug: Input Events (F12 to close)", x, y, FontSizeSmall, ColorPrimary)
+	DrawText(screen, "Debug (F12 to close)", x, y, FontSizeSmall, ColorPrimary)
+	y += lineH
+
+	DrawText(screen, fmt.Sprintf("FPS %.1f  TPS %.1f  heap %.1f MB  sys %.1f MB  GC %d  goroutines %d",
+		ebiten.ActualFPS(), ebiten.ActualTPS(),
+		float64(debugMemStats.HeapAlloc)/(1<<20), float64(debugMemStats.Sys)/(1<<20),
+		debugMemStats.NumGC, runtime.NumGoroutine()), x, y, FontSizeSmall, ColorText)
 	y += lineH
 
+	if imgCache != nil {
+		st := imgCache.Stats()
+		DrawText(screen, fmt.Sprintf("Images: %d memory  %d disk  %d downloaded  %d failed",
+			st.MemoryHits, st.DiskHits, st.Downloads, st.Failures), x, y, FontSizeSmall, ColorText)
+	}
+	y += lineH * 1.5
+
+	DrawText(screen, "--- recent requests ---", x, y, FontSizeSmall, ColorTextMuted)
+	y += lineH
+	if len(requests) == 0 {
+		DrawText(screen, "(none)", x, y, FontSizeSmall, ColorTextSecondary)
+		y += lineH
+	}
+	for _, r := range requests {
+		status, clr := fmt.Sprintf("%d", r.Status), ColorText
+		switch {
+		case r.Err != "":
+			status, clr = "ERR", ColorError
+		case r.Status >= 400:
+			clr = ColorError
+		case r.Duration > time.Second:
+			clr = ColorAccent
+		}
+		line := fmt.Sprintf("%s %-10s %-4s %s %5dms  %s", r.Time.Format("15:04:05"), r.Source, r.Method,
+			status, r.Duration.Milliseconds(), r.Path)
+		DrawText(screen, truncateText(line, panelW-padX*2, FontSizeSmall), x, y, FontSizeSmall, clr)
+		y += lineH
+	}
+	y += lineH * 0.5
+
 	DrawText(screen, "--- evdev key presses ---", x, y, FontSizeSmall, ColorTextMuted)
 	y += lineH
 
@@ -70,7 +127,11 @@ func DrawDebugOverlay(screen *ebiten.Image) {
 		now := time.Now()
 		for _, ev := range evdevEvents {
 			age := now.Sub(ev.Time).Truncate(time.Millisecond)
-			line := fmt.Sprintf("%s  code=%-4d  type=%-2d  val=%d  %s ago", ev.Device, ev.Code, ev.Type, ev.Value, age)
+			dev := ev.Device
+			if ev.Name != "" {
+				dev += " (" + ev.Name + ")"
+			}
+			line := fmt.Sprintf("%s  code=%-4d  type=%-2d  val=%d  %s ago", dev, ev.Code, ev.Type, ev.Value, age)
 			DrawText(screen, line, x, y, FontSizeSmall, ColorText)
 			y += lineH
 		}
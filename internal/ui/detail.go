@@ -13,6 +13,11 @@ type ButtonRect struct {
 	X, Y, W, H float64
 }
 
+// Hit reports whether (px, py) is on the button, including HitPadding.
+func (r ButtonRect) Hit(px, py int) bool {
+	return PointInHitRect(px, py, r.X, r.Y, r.W, r.H)
+}
+
 // DetailPanel shows item metadata with a backdrop.
 type DetailPanel struct {
 	Title          string
@@ -21,7 +26,13 @@ type DetailPanel struct {
 	Runtime        string
 	Overview       string
 	RatingValue    float32
-	OfficialRating string
+	CriticRating   float32 // percentage, 0 = none
+	OfficialRating string  // age rating, drawn as a badge
+	Credits        string  // key crew, e.g. "Directed by …"
+	FileInfo       string  // file path and size, shown to administrators
+	ResumeTicks    int64   // resume position; with RuntimeTicks, draws a progress bar
+	RuntimeTicks   int64
+	ChapterTicks   []int64 // chapter starts, drawn as ticks on the progress bar
 	Genres         string
 	Tagline        string
 	Backdrop       *ebiten.Image
@@ -63,7 +74,9 @@ func (dp *DetailPanel) HandleClick(mx, my int) (buttonIndex int, ok bool) {
 	return -1, false
 }
 
-func (dp *DetailPanel) Draw(dst *ebiten.Image) {
+// Draw renders the panel and returns the y of its bottom edge, below the
+// action buttons, for the screen to lay out what follows.
+func (dp *DetailPanel) Draw(dst *ebiten.Image) float64 {
 	sw := float64(ScreenWidth)
 
 	// Backdrop
@@ -81,9 +94,10 @@ func (dp *DetailPanel) Draw(dst *ebiten.Image) {
 		dst.DrawImage(dp.Backdrop, op)
 	}
 
-	// Gradient overlay at bottom of backdrop
-	vector.DrawFilledRect(dst, 0, float32(BackdropHeight-100), float32(sw), 100,
-		ColorOverlay, false)
+	// Gradient overlay fading the backdrop into the metadata area
+	const gradientH = 200
+	drawVerticalGradient(dst, 0, float32(BackdropHeight-gradientH), float32(sw), gradientH,
+		0, BackdropDim)
 	// Solid background below backdrop to cover image bleed and ensure readable text
 	vector.DrawFilledRect(dst, 0, float32(BackdropHeight), float32(sw), float32(ScreenHeight-BackdropHeight),
 		ColorBackground, false)
@@ -104,12 +118,6 @@ func (dp *DetailPanel) Draw(dst *ebiten.Image) {
 		}
 		meta += dp.Runtime
 	}
-	if dp.OfficialRating != "" {
-		if meta != "" {
-			meta += "  •  "
-		}
-		meta += dp.OfficialRating
-	}
 	if dp.Genres != "" {
 		if meta != "" {
 			meta += "  •  "
@@ -119,26 +127,58 @@ func (dp *DetailPanel) Draw(dst *ebiten.Image) {
 	if meta != "" {
 		DrawText(dst, meta, SectionPadding, y, FontSizeBody, ColorTextSecondary)
 	}
-	// Draw rating with vector star after meta text
+	// Ratings and the age rating badge follow the meta text
+	x := float64(SectionPadding)
+	if meta != "" {
+		tw, _ := MeasureText(meta, FontSizeBody)
+		x += tw + 20
+	}
 	if dp.RatingValue > 0 {
 		ratingText := fmt.Sprintf("%.1f", dp.RatingValue)
-		var metaEndX float64
-		if meta != "" {
-			tw, _ := MeasureText(meta, FontSizeBody)
-			metaEndX = SectionPadding + tw + 20
-		} else {
-			metaEndX = SectionPadding
-		}
 		starR := float32(FontSizeBody * 0.4)
-		starCX := float32(metaEndX) + starR
+		starCX := float32(x) + starR
 		starCY := float32(y) + float32(FontSizeBody)*0.45
 		drawStarIcon(dst, starCX, starCY, starR, color.RGBA{R: 0xFF, G: 0xD7, B: 0x00, A: 0xFF})
 		DrawText(dst, ratingText, float64(starCX+starR+4), y, FontSizeBody, ColorTextSecondary)
+		tw, _ := MeasureText(ratingText, FontSizeBody)
+		x = float64(starCX+starR+4) + tw + 20
 	}
-	if meta != "" || dp.RatingValue > 0 {
+	if dp.CriticRating > 0 {
+		// Critic score with a dot, red when fresh (60% and up), green when rotten
+		dotR := float32(FontSizeBody * 0.35)
+		dotClr := color.RGBA{R: 0xFA, G: 0x32, B: 0x0A, A: 0xFF}
+		if dp.CriticRating < 60 {
+			dotClr = color.RGBA{R: 0x6A, G: 0xB0, B: 0x4C, A: 0xFF}
+		}
+		vector.DrawFilledCircle(dst, float32(x)+dotR, float32(y)+float32(FontSizeBody)*0.45, dotR, dotClr, true)
+		criticText := fmt.Sprintf("%d%%", int(dp.CriticRating))
+		DrawText(dst, criticText, x+float64(dotR*2)+6, y, FontSizeBody, ColorTextSecondary)
+		tw, _ := MeasureText(criticText, FontSizeBody)
+		x += float64(dotR*2) + 6 + tw + 20
+	}
+	if dp.OfficialRating != "" {
+		tw, _ := MeasureText(dp.OfficialRating, FontSizeSmall)
+		bw, bh := tw+14, float64(FontSizeBody+6)
+		by := y - 3
+		vector.StrokeRect(dst, float32(x), float32(by), float32(bw), float32(bh), 1.5, ColorTextSecondary, true)
+		DrawTextCentered(dst, dp.OfficialRating, x+bw/2, by+bh/2, FontSizeSmall, ColorTextSecondary)
+	}
+	if meta != "" || dp.RatingValue > 0 || dp.CriticRating > 0 || dp.OfficialRating != "" {
 		y += FontSizeBody + 12
 	}
 
+	// Key crew
+	if dp.Credits != "" {
+		credits := truncateText(dp.Credits, sw-SectionPadding*2-400, FontSizeSmall)
+		DrawText(dst, credits, SectionPadding, y, FontSizeSmall, ColorTextMuted)
+		y += FontSizeSmall + 10
+	}
+	if dp.FileInfo != "" {
+		info := truncateText(dp.FileInfo, sw-SectionPadding*2-400, FontSizeSmall)
+		DrawText(dst, info, SectionPadding, y, FontSizeSmall, ColorTextMuted)
+		y += FontSizeSmall + 10
+	}
+
 	// Tagline
 	if dp.Tagline != "" {
 		DrawText(dst, dp.Tagline, SectionPadding, y, FontSizeBody, ColorTextMuted)
@@ -152,13 +192,19 @@ func (dp *DetailPanel) Draw(dst *ebiten.Image) {
 		y += h + 16
 	}
 
+	// Resume progress
+	if dp.ResumeTicks > 0 && dp.RuntimeTicks > 0 {
+		dp.drawResumeProgress(dst, SectionPadding, y)
+		y += FontSizeSmall + 18
+	}
+
 	// Action buttons — measure properly
 	dp.ButtonRects = make([]ButtonRect, len(dp.Buttons))
 	btnX := float64(SectionPadding)
 	for i, label := range dp.Buttons {
 		tw, _ := MeasureText(label, FontSizeBody)
 		w := tw + 40
-		h := float64(36)
+		h := float64(detailButtonH)
 		bx := float32(btnX)
 		by := float32(y)
 
@@ -173,12 +219,30 @@ func (dp *DetailPanel) Draw(dst *ebiten.Image) {
 		}
 		btnX += w + 12
 	}
+	return y + detailButtonH
 }
 
-func FormatRuntime(ticks int64) string {
-	minutes := ticks / 600_000_000
-	if minutes < 60 {
-		return fmt.Sprintf("%dm", minutes)
+// detailButtonH is the height of the action buttons.
+const detailButtonH = 36.0
+
+// resumeBarW is the width of the detail panel's resume progress bar.
+const resumeBarW = 560.0
+
+// drawResumeProgress draws the resume position as a bar with chapter ticks,
+// labelled e.g. "42:15 / 1:58:00 (36%)".
+func (dp *DetailPanel) drawResumeProgress(dst *ebiten.Image, x, y float64) {
+	frac := min(float64(dp.ResumeTicks)/float64(dp.RuntimeTicks), 1)
+	barY := y + FontSizeSmall/2 - 2
+	vector.DrawFilledRect(dst, float32(x), float32(barY), resumeBarW, 4, ColorSurfaceHover, false)
+	vector.DrawFilledRect(dst, float32(x), float32(barY), float32(resumeBarW*frac), 4, ColorPrimary, false)
+	for _, ticks := range dp.ChapterTicks {
+		if ticks <= 0 || ticks >= dp.RuntimeTicks {
+			continue
+		}
+		cx := x + resumeBarW*float64(ticks)/float64(dp.RuntimeTicks)
+		vector.DrawFilledRect(dst, float32(cx-1), float32(barY-3), 2, 10, ColorTextMuted, false)
 	}
-	return fmt.Sprintf("%dh %dm", minutes/60, minutes%60)
+
+	label := fmt.Sprintf("%s / %s (%d%%)", FormatTimecode(dp.ResumeTicks), FormatTimecode(dp.RuntimeTicks), int(frac*100))
+	DrawText(dst, label, x+resumeBarW+16, y, FontSizeSmall, ColorTextSecondary)
 }
@@ -1,8 +1,10 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"sync"
 
@@ -19,6 +21,11 @@ type DetailScreen struct {
 	imgCache *cache.ImageCache
 	item     jellyfin.MediaItem
 
+	// loadCtx is cancelled in OnExit, aborting the screen's own fetches;
+	// playback and watched changes don't use it
+	loadCtx    context.Context
+	cancelLoad context.CancelFunc
+
 	detail   *DetailPanel
 	backdrop *ebiten.Image
 
@@ -29,17 +36,32 @@ type DetailScreen struct {
 	selectedSeason int
 	episodesLoading bool
 
+	// Series "Continue" target, resolved from the server's next-up
+	nextUp      *jellyfin.MediaItem
+	continueBtn string // current label of the Continue button ("" = not shown)
+
 	// Season tab rects for mouse clicks
 	seasonTabRects []ButtonRect
+	// Season posters shown in the tabs, by season ID
+	seasonPosters map[string]*ebiten.Image
+	// Episode stills shown in the episode cards, by episode ID
+	episodeStills map[string]*ebiten.Image
 	// Episode rects for mouse clicks
 	episodeRects []ButtonRect
 
-	// Focus mode: 0=buttons, 1=episodes, 2=season tabs
+	// Extras (special features) of a movie; extrasRow is nil when there are none
+	extras    []jellyfin.MediaItem
+	extrasRow *PosterGrid
+
+	// Focus mode: 0=buttons, 1=episodes, 2=season tabs, 3=extras
 	focusMode  int
 	loaded     bool
 
+	contextMenu *ContextMenu // open on an episode, or on the item itself
+
 	OnPlay    func(item jellyfin.MediaItem, resumeTicks int64)
 	OnLibrary func(parentID, title string)
+	OnPerson  func(person jellyfin.Person) // picked from the item menu's Cast & Crew
 
 	mu sync.Mutex
 }
@@ -51,6 +73,7 @@ func NewDetailScreen(client *jellyfin.Client, imgCache *cache.ImageCache, item j
 		item:   item,
 		detail: NewDetailPanel(),
 	}
+	ds.loadCtx, ds.cancelLoad = context.WithCancel(client.Context())
 
 	ds.detail.Title = item.Name
 	if item.Year > 0 {
@@ -62,8 +85,14 @@ func NewDetailScreen(client *jellyfin.Client, imgCache *cache.ImageCache, item j
 	if item.CommunityRating > 0 {
 		ds.detail.RatingValue = item.CommunityRating
 	}
+	ds.detail.CriticRating = item.CriticRating
 	ds.detail.Overview = item.Overview
 	ds.detail.OfficialRating = item.OfficialRating
+	ds.detail.Credits = creditsLine(item)
+	if item.PlaybackPositionTicks > 0 && item.RuntimeTicks > 0 {
+		ds.detail.ResumeTicks = item.PlaybackPositionTicks
+		ds.detail.RuntimeTicks = item.RuntimeTicks
+	}
 	if len(item.Genres) > 0 {
 		ds.detail.Genres = strings.Join(item.Genres, ", ")
 	}
@@ -71,13 +100,17 @@ func NewDetailScreen(client *jellyfin.Client, imgCache *cache.ImageCache, item j
 		ds.detail.Tagline = item.Taglines[0]
 	}
 
-	// Determine buttons
-	buttons := []string{"Play"}
-	if item.PlaybackPositionTicks > 0 {
-		buttons = append([]string{"Resume"}, buttons...)
-	}
+	// Determine buttons. Series play an episode rather than the series item:
+	// "Continue" resolves to the next-up episode once loaded.
+	var buttons []string
 	if item.Type == "Series" {
-		buttons = append(buttons, "Browse Seasons")
+		ds.continueBtn = "Continue"
+		buttons = []string{ds.continueBtn, "Play from S1E1", "Random Episode", "Browse Seasons"}
+	} else {
+		buttons = []string{"Play"}
+		if item.PlaybackPositionTicks > 0 {
+			buttons = append([]string{"Resume"}, buttons...)
+		}
 	}
 	buttons = append(buttons, toggleWatchedLabel(item.Played))
 	ds.detail.Buttons = buttons
@@ -85,6 +118,27 @@ func NewDetailScreen(client *jellyfin.Client, imgCache *cache.ImageCache, item j
 	return ds
 }
 
+// creditsMaxNames is how many directors or writers creditsLine names.
+const creditsMaxNames = 3
+
+// creditsLine returns the "Directed by … • Written by …" line for item.
+func creditsLine(item jellyfin.MediaItem) string {
+	names := func(all []string) string {
+		if len(all) > creditsMaxNames {
+			all = all[:creditsMaxNames]
+		}
+		return strings.Join(all, ", ")
+	}
+	var parts []string
+	if len(item.Directors) > 0 {
+		parts = append(parts, Tf("detail.directed_by", names(item.Directors)))
+	}
+	if len(item.Writers) > 0 {
+		parts = append(parts, Tf("detail.written_by", names(item.Writers)))
+	}
+	return strings.Join(parts, "  •  ")
+}
+
 func toggleWatchedLabel(played bool) string {
 	if played {
 		return "Mark Unwatched"
@@ -96,12 +150,16 @@ func (ds *DetailScreen) Name() string { return "Detail: " + ds.item.Name }
 
 func (ds *DetailScreen) OnEnter() {
 	go ds.loadBackdrop()
+	go ds.loadCredits()
 	if ds.item.Type == "Series" {
 		go ds.loadSeasons()
+		go ds.loadNextUp()
+	} else {
+		go ds.loadExtras()
 	}
 }
 
-func (ds *DetailScreen) OnExit() {}
+func (ds *DetailScreen) OnExit() { ds.cancelLoad() }
 
 func (ds *DetailScreen) loadBackdrop() {
 	url := ds.client.GetBackdropURL(ds.item.ID)
@@ -112,8 +170,68 @@ func (ds *DetailScreen) loadBackdrop() {
 	})
 }
 
+// loadCredits fetches the full item for its crew and critic rating, which
+// list endpoints leave out, and for administrators its file.
+func (ds *DetailScreen) loadCredits() {
+	full, err := ds.client.GetItemContext(ds.loadCtx, ds.item.ID)
+	if err != nil {
+		log.Printf("Failed to load credits: %v", err)
+		return
+	}
+	var fileInfo string
+	if full.Path != "" && lookupIsAdmin(ds.client) {
+		fileInfo = full.Path
+		if full.Size > 0 {
+			fileInfo += " • " + FormatFileSize(full.Size)
+		}
+	}
+	ds.mu.Lock()
+	ds.item.Path = full.Path
+	ds.item.Size = full.Size
+	ds.detail.FileInfo = fileInfo
+	ds.item.Directors = full.Directors
+	ds.item.Writers = full.Writers
+	ds.item.People = full.People
+	ds.item.CriticRating = full.CriticRating
+	ds.detail.Credits = creditsLine(ds.item)
+	ds.detail.CriticRating = full.CriticRating
+	ds.detail.ChapterTicks = full.Chapters
+	ds.mu.Unlock()
+}
+
+// loadExtras fills the Extras row with the item's special features.
+func (ds *DetailScreen) loadExtras() {
+	extras, err := ds.client.GetSpecialFeaturesContext(ds.loadCtx, ds.item.ID)
+	if err != nil {
+		log.Printf("Failed to load extras for %s: %v", ds.item.Name, err)
+		return
+	}
+	if len(extras) == 0 {
+		return
+	}
+	row := NewPosterGrid(T("detail.extras"))
+	items := make([]GridItem, len(extras))
+	for i, extra := range extras {
+		items[i] = GridItemFromMediaItem(extra)
+	}
+	LoadGridItemImages(ds.client, ds.imgCache, &items, extras, &ds.mu)
+
+	ds.mu.Lock()
+	row.Items = items
+	ds.extras = extras
+	ds.extrasRow = row
+	ds.mu.Unlock()
+}
+
+// playExtra plays the extra at idx from the start. Caller must hold ds.mu.
+func (ds *DetailScreen) playExtra(idx int) {
+	if idx < len(ds.extras) && ds.OnPlay != nil {
+		ds.OnPlay(ds.extras[idx], 0)
+	}
+}
+
 func (ds *DetailScreen) loadSeasons() {
-	seasons, err := ds.client.GetSeasons(ds.item.ID)
+	seasons, err := ds.client.GetSeasonsContext(ds.loadCtx, ds.item.ID)
 	if err != nil {
 		log.Printf("Failed to load seasons: %v", err)
 		return
@@ -123,17 +241,124 @@ func (ds *DetailScreen) loadSeasons() {
 	ds.loaded = true
 	ds.mu.Unlock()
 
+	ds.loadSeasonPosters(seasons)
 	if len(seasons) > 0 {
 		ds.loadEpisodes(seasons[0].ID)
 	}
 }
 
+// Size of the season posters in the season tabs
+const (
+	seasonThumbW = 32.0
+	seasonThumbH = 48.0
+)
+
+// loadSeasonPosters fetches the poster of every season that has its own.
+func (ds *DetailScreen) loadSeasonPosters(seasons []jellyfin.MediaItem) {
+	for _, season := range seasons {
+		if season.ImageTags["Primary"] == "" {
+			continue
+		}
+		id := season.ID
+		url := ds.client.GetImageURL(id, jellyfin.ImagePrimary, 0, int(seasonThumbH)*2)
+		ds.imgCache.LoadAsync(url, func(img *ebiten.Image) {
+			ds.mu.Lock()
+			if ds.seasonPosters == nil {
+				ds.seasonPosters = make(map[string]*ebiten.Image)
+			}
+			ds.seasonPosters[id] = img
+			ds.mu.Unlock()
+		})
+	}
+}
+
+// loadNextUp resolves the series' Continue button to the next-up episode,
+// dropping the button when the whole series has been watched.
+func (ds *DetailScreen) loadNextUp() {
+	ep, err := ds.client.GetSeriesNextUpContext(ds.loadCtx, ds.item.ID)
+	if err != nil {
+		log.Printf("Failed to load next up for %s: %v", ds.item.Name, err)
+	}
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	idx := -1
+	for i, btn := range ds.detail.Buttons {
+		if btn == ds.continueBtn {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return
+	}
+	if ep == nil {
+		ds.detail.Buttons = append(ds.detail.Buttons[:idx:idx], ds.detail.Buttons[idx+1:]...)
+		if ds.detail.ButtonIndex > idx {
+			ds.detail.ButtonIndex--
+		}
+		ds.continueBtn = ""
+		return
+	}
+	verb := "Continue"
+	if ep.PlaybackPositionTicks > 0 {
+		verb = "Resume"
+	}
+	ds.nextUp = ep
+	ds.continueBtn = fmt.Sprintf("%s S%dE%d", verb, ep.ParentIndexNumber, ep.IndexNumber)
+	ds.detail.Buttons[idx] = ds.continueBtn
+}
+
+// playFirstEpisode plays the first episode of the first regular season
+// (specials only if the series has nothing else). Caller must hold ds.mu.
+func (ds *DetailScreen) playFirstEpisode() {
+	if ds.OnPlay == nil {
+		return
+	}
+	seasons := ds.seasons
+	if len(seasons) == 0 {
+		var err error
+		if seasons, err = ds.client.GetSeasons(ds.item.ID); err != nil || len(seasons) == 0 {
+			log.Printf("Failed to load seasons for %s: %v", ds.item.Name, err)
+			return
+		}
+	}
+	season := seasons[0]
+	for _, s := range seasons {
+		if s.IndexNumber > 0 {
+			season = s
+			break
+		}
+	}
+	episodes, err := ds.client.GetEpisodes(ds.item.ID, season.ID)
+	if err != nil || len(episodes) == 0 {
+		log.Printf("Failed to load episodes for %s: %v", season.Name, err)
+		return
+	}
+	sort.SliceStable(episodes, func(i, j int) bool { return episodes[i].IndexNumber < episodes[j].IndexNumber })
+	ds.OnPlay(episodes[0], 0)
+}
+
+// playRandomEpisode plays a random episode of the series from the start.
+// Next-episode playback continues from it as usual. Caller must hold ds.mu.
+func (ds *DetailScreen) playRandomEpisode() {
+	if ds.OnPlay == nil {
+		return
+	}
+	ep, err := ds.client.GetRandomEpisode(ds.item.ID)
+	if err != nil || ep == nil {
+		log.Printf("Failed to pick a random episode of %s: %v", ds.item.Name, err)
+		return
+	}
+	ds.OnPlay(*ep, 0)
+}
+
 func (ds *DetailScreen) loadEpisodes(seasonID string) {
 	ds.mu.Lock()
 	ds.episodesLoading = true
 	ds.mu.Unlock()
 
-	episodes, err := ds.client.GetEpisodes(ds.item.ID, seasonID)
+	episodes, err := ds.client.GetEpisodesContext(ds.loadCtx, ds.item.ID, seasonID)
 	if err != nil {
 		log.Printf("Failed to load episodes: %v", err)
 		ds.mu.Lock()
@@ -143,16 +368,58 @@ func (ds *DetailScreen) loadEpisodes(seasonID string) {
 	}
 	ds.mu.Lock()
 	ds.episodes = episodes
-	cols := (ScreenWidth - SectionPadding*2) / (PosterWidth + PosterGap)
+	cols := (ScreenWidth - SectionPadding*2) / (EpisodeCardWidth + PosterGap)
 	ds.episodeGrid = NewFocusGrid(cols, len(episodes))
+	ds.episodeGrid.PosterW, ds.episodeGrid.PosterH = EpisodeCardWidth, EpisodeCardHeight
 	ds.episodesLoading = false
 	ds.mu.Unlock()
+
+	ds.loadEpisodeStills(episodes)
+}
+
+// loadEpisodeStills fetches each episode's still: its Primary image, or its
+// Thumb when it has no Primary.
+func (ds *DetailScreen) loadEpisodeStills(episodes []jellyfin.MediaItem) {
+	for _, ep := range episodes {
+		imgType := jellyfin.ImagePrimary
+		if ep.ImageTags["Primary"] == "" {
+			if ep.ImageTags["Thumb"] == "" {
+				continue
+			}
+			imgType = jellyfin.ImageThumb
+		}
+		id := ep.ID
+		url := ds.client.GetImageURL(id, imgType, EpisodeCardWidth*2, 0)
+		ds.imgCache.LoadAsync(url, func(img *ebiten.Image) {
+			ds.mu.Lock()
+			if ds.episodeStills == nil {
+				ds.episodeStills = make(map[string]*ebiten.Image)
+			}
+			ds.episodeStills[id] = img
+			ds.mu.Unlock()
+		})
+	}
 }
 
 func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 	ds.mu.Lock()
 	defer ds.mu.Unlock()
 
+	// Context menu captures all input while open
+	if ds.contextMenu != nil {
+		ds.contextMenu.Update()
+		if done, action, ok := ds.contextMenu.Done(); done {
+			item, target := ds.contextMenu.Item(), ds.contextMenu.Target()
+			ds.contextMenu = nil
+			if ok && item.ID == ds.item.ID {
+				ds.runItemAction(item, action, target)
+			} else if ok {
+				ds.runEpisodeAction(item, action, target)
+			}
+		}
+		return nil, nil
+	}
+
 	dir, enter, back := InputState()
 
 	if back {
@@ -179,6 +446,16 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 				return nil, nil
 			}
 		}
+		// Check extras
+		if ds.extrasRow != nil {
+			if idx, ok := ds.extrasRow.HandleClick(mx, my); ok {
+				ds.extrasRow.Focused = idx
+				ds.focusMode = 3
+				ds.extrasRow.Active = true
+				ds.playExtra(idx)
+				return nil, nil
+			}
+		}
 		// Check episode items
 		for i, rect := range ds.episodeRects {
 			if PointInRect(mx, my, rect.X, rect.Y, rect.W, rect.H) {
@@ -194,12 +471,19 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 		}
 	}
 
-	// Right-click: toggle watched state on episodes
+	// Right-click on an episode: context menu or toggle watched, per PosterRightClick
 	rmx, rmy, rclicked := MouseJustRightClicked()
-	if rclicked {
+	if rclicked && PosterRightClick != RightClickNone {
 		for i, rect := range ds.episodeRects {
 			if PointInRect(rmx, rmy, rect.X, rect.Y, rect.W, rect.H) {
-				if i < len(ds.episodes) {
+				if i >= len(ds.episodes) {
+					return nil, nil
+				}
+				if PosterRightClick == RightClickMenu && ds.episodeGrid != nil {
+					ds.episodeGrid.Focused = i
+					ds.focusMode = 1
+					ds.openEpisodeMenu()
+				} else {
 					ds.episodes[i].Played = ToggleWatched(ds.client, ds.episodes[i].ID, ds.episodes[i].Played)
 				}
 				return nil, nil
@@ -209,6 +493,10 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 
 	switch ds.focusMode {
 	case 0: // buttons
+		if ContextMenuKeyPressed() {
+			ds.openItemMenu()
+			return nil, nil
+		}
 		if dir == DirUp {
 			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
 		}
@@ -217,6 +505,9 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 				ds.focusMode = 2
 			} else if ds.episodeGrid != nil && len(ds.episodes) > 0 {
 				ds.focusMode = 1
+			} else if ds.extrasRow != nil {
+				ds.focusMode = 3
+				ds.extrasRow.Active = true
 			}
 		} else {
 			ds.detail.Update(dir)
@@ -226,6 +517,19 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 			ds.handleButtonPress()
 		}
 
+	case 3: // extras
+		switch dir {
+		case DirUp:
+			ds.focusMode = 0
+			ds.extrasRow.Active = false
+		case DirLeft, DirRight:
+			ds.extrasRow.Update(dir)
+		}
+
+		if enter {
+			ds.playExtra(ds.extrasRow.Focused)
+		}
+
 	case 2: // season tabs
 		switch dir {
 		case DirUp:
@@ -257,6 +561,11 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 			ds.episodeGrid.Update(dir)
 		}
 
+		if ContextMenuKeyPressed() {
+			ds.openEpisodeMenu()
+			return nil, nil
+		}
+
 		if enter && ds.episodeGrid != nil {
 			idx := ds.episodeGrid.Focused
 			if idx < len(ds.episodes) {
@@ -271,6 +580,124 @@ func (ds *DetailScreen) Update() (*ScreenTransition, error) {
 	return nil, nil
 }
 
+// openEpisodeMenu opens the context menu for the focused episode.
+// Caller must hold ds.mu.
+func (ds *DetailScreen) openEpisodeMenu() {
+	idx := ds.episodeGrid.Focused
+	if idx >= len(ds.episodes) || idx >= len(ds.episodeRects) {
+		return
+	}
+	item := GridItemFromMediaItem(ds.episodes[idx])
+	item.SeriesID = "" // no "Go to Series", this is the series
+	r := ds.episodeRects[idx]
+	ds.contextMenu = NewContextMenu(item, r.X+r.W/2, r.Y+r.H/3, ContextMenuOptions{
+		InSeason:   true,
+		CanRefresh: cachedIsAdmin(ds.client),
+		Playlists:  cachedPlaylists(ds.client),
+	})
+}
+
+// openItemMenu opens the context menu for the item the screen shows,
+// anchored at the focused button. Caller must hold ds.mu.
+func (ds *DetailScreen) openItemMenu() {
+	item := GridItemFromMediaItem(ds.item)
+	item.SeriesID = ""
+	x, y := float64(SectionPadding), float64(BackdropHeight)
+	if i := ds.detail.ButtonIndex; i < len(ds.detail.ButtonRects) {
+		r := ds.detail.ButtonRects[i]
+		x, y = r.X, r.Y+r.H
+	}
+	ds.contextMenu = NewContextMenu(item, x, y, ContextMenuOptions{
+		CanRefresh: cachedIsAdmin(ds.client),
+		Playlists:  cachedPlaylists(ds.client),
+		People:     ds.item.People,
+	})
+}
+
+// runItemAction applies a context menu action to the item the screen shows.
+// Caller must hold ds.mu.
+func (ds *DetailScreen) runItemAction(item GridItem, action ContextAction, target string) {
+	runContextAction(ds.client, &item, action, target, gridActionCallbacks{OnPlay: ds.OnPlay, OnPerson: ds.showPerson})
+	ds.item.Played = item.Watched
+	if ds.item.UserData != nil {
+		ds.item.UserData.IsFavorite = item.Favorite
+	}
+	ds.updateWatchedButton()
+}
+
+// showPerson opens the filmography of a credited person. Caller must hold ds.mu.
+func (ds *DetailScreen) showPerson(personID string) {
+	for _, p := range ds.item.People {
+		if p.ID == personID && ds.OnPerson != nil {
+			ds.OnPerson(p)
+			return
+		}
+	}
+}
+
+// runEpisodeAction applies a context menu action to the episode item.
+// Caller must hold ds.mu.
+func (ds *DetailScreen) runEpisodeAction(item GridItem, action ContextAction, target string) {
+	idx := -1
+	for i, ep := range ds.episodes {
+		if ep.ID == item.ID {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return
+	}
+	switch action {
+	case ActionMarkWatchedUpTo, ActionMarkSeriesWatchedUpTo:
+		ds.markWatchedUpTo(idx, action == ActionMarkSeriesWatchedUpTo)
+		return
+	}
+	runContextAction(ds.client, &item, action, target, gridActionCallbacks{OnPlay: ds.OnPlay})
+	ds.episodes[idx].Played = item.Watched
+	ds.episodes[idx].UserData.IsFavorite = item.Favorite
+}
+
+// markWatchedUpTo marks the season's episodes up to and including idx as
+// watched; with allSeasons, every episode of the earlier regular seasons too.
+// The grid updates at once and the requests run in the background.
+// Caller must hold ds.mu.
+func (ds *DetailScreen) markWatchedUpTo(idx int, allSeasons bool) {
+	var ids []string
+	for i := 0; i <= idx; i++ {
+		if !ds.episodes[i].Played {
+			ids = append(ids, ds.episodes[i].ID)
+			ds.episodes[i].Played = true
+		}
+	}
+	var earlier []jellyfin.MediaItem
+	if allSeasons && ds.selectedSeason < len(ds.seasons) {
+		for _, season := range ds.seasons[:ds.selectedSeason] {
+			if season.IndexNumber > 0 { // not specials
+				earlier = append(earlier, season)
+			}
+		}
+	}
+
+	seriesID := ds.item.ID
+	go func() {
+		for _, season := range earlier {
+			episodes, err := ds.client.GetEpisodes(seriesID, season.ID)
+			if err != nil {
+				log.Printf("Failed to load episodes for %s: %v", season.Name, err)
+				continue
+			}
+			for _, ep := range episodes {
+				if !ep.Played {
+					ids = append(ids, ep.ID)
+				}
+			}
+		}
+		if err := ds.client.MarkPlayedBatch(ids); err != nil {
+			log.Printf("Failed to mark episodes watched: %v", err)
+		}
+	}()
+}
+
 func (ds *DetailScreen) handleButtonPress() {
 	btn := ds.detail.Buttons[ds.detail.ButtonIndex]
 	switch btn {
@@ -282,6 +709,14 @@ func (ds *DetailScreen) handleButtonPress() {
 		if ds.OnPlay != nil {
 			ds.OnPlay(ds.item, ds.item.PlaybackPositionTicks)
 		}
+	case "Play from S1E1":
+		ds.playFirstEpisode()
+	case "Random Episode":
+		ds.playRandomEpisode()
+	case ds.continueBtn:
+		if ds.nextUp != nil && ds.OnPlay != nil {
+			ds.OnPlay(*ds.nextUp, ds.nextUp.PlaybackPositionTicks)
+		}
 	case "Browse Seasons":
 		if ds.OnLibrary != nil {
 			ds.OnLibrary(ds.item.ID, ds.item.Name)
@@ -305,15 +740,37 @@ func (ds *DetailScreen) Draw(dst *ebiten.Image) {
 	ds.mu.Lock()
 	defer ds.mu.Unlock()
 
-	ds.detail.Draw(dst)
+	// Seasons, episodes and extras start below the panel, whose height
+	// depends on the credits, file info and resume bar shown
+	top := ds.detail.Draw(dst) + detailSectionGap
+	ds.drawEpisodes(dst, top)
+	if ds.extrasRow != nil {
+		ds.extrasRow.Draw(dst, SectionPadding, top)
+	}
 
+	if ds.contextMenu != nil {
+		ds.contextMenu.Draw(dst)
+	}
+}
+
+// detailSectionGap separates the detail panel's buttons from the sections
+// below them.
+const detailSectionGap = 32.0
+
+// drawEpisodes draws the season tabs and episode grid of a series, starting
+// at y.
+func (ds *DetailScreen) drawEpisodes(dst *ebiten.Image, y float64) {
 	// Episode list for TV shows
 	if len(ds.seasons) > 0 || (ds.episodeGrid != nil && len(ds.episodes) > 0) {
-		y := float64(BackdropHeight + 250)
-
 		// Season tabs
 		if len(ds.seasons) > 0 {
 			ds.seasonTabRects = make([]ButtonRect, len(ds.seasons))
+			// Once any season poster has loaded, every tab makes room for one
+			thumbs := len(ds.seasonPosters) > 0
+			contentH := float64(FontSizeBody)
+			if thumbs {
+				contentH = seasonThumbH
+			}
 			tabX := float64(SectionPadding)
 			for i, season := range ds.seasons {
 				label := season.Name
@@ -321,30 +778,43 @@ func (ds *DetailScreen) Draw(dst *ebiten.Image) {
 					label = fmt.Sprintf("Season %d", season.IndexNumber)
 				}
 				w, _ := MeasureText(label, FontSizeBody)
-				tabH := FontSizeBody + 12.0
+				tabH := contentH + 12.0
+				labelX, labelY := tabX, y+(contentH-FontSizeBody)/2
+				if thumbs {
+					labelX += seasonThumbW + 8
+					w += seasonThumbW + 8
+				}
 
 				ds.seasonTabRects[i] = ButtonRect{X: tabX - 4, Y: y - 4, W: w + 8, H: tabH}
 
-				if i == ds.selectedSeason {
-					if ds.focusMode == 2 {
-						// Highlighted background when season tabs are focused
-						vector.DrawFilledRect(dst, float32(tabX-8), float32(y-6),
-							float32(w+16), float32(tabH+4), ColorSurfaceHover, false)
+				selected := i == ds.selectedSeason
+				if selected && ds.focusMode == 2 {
+					// Highlighted background when season tabs are focused
+					vector.DrawFilledRect(dst, float32(tabX-8), float32(y-6),
+						float32(w+16), float32(tabH+4), ColorSurfaceHover, false)
+				}
+				if thumbs {
+					if img := ds.seasonPosters[season.ID]; img != nil {
+						DrawImageCover(dst, img, tabX, y, seasonThumbW, seasonThumbH)
+					} else {
+						vector.DrawFilledRect(dst, float32(tabX), float32(y), seasonThumbW, seasonThumbH, ColorSurface, false)
 					}
-					DrawText(dst, label, tabX, y, FontSizeBody, ColorPrimary)
-					vector.DrawFilledRect(dst, float32(tabX-4), float32(y+FontSizeBody+2),
+				}
+				if selected {
+					DrawText(dst, label, labelX, labelY, FontSizeBody, ColorPrimary)
+					vector.DrawFilledRect(dst, float32(tabX-4), float32(y+contentH+2),
 						float32(w+8), 2, ColorPrimary, false)
 				} else {
-					DrawText(dst, label, tabX, y, FontSizeBody, ColorTextMuted)
+					DrawText(dst, label, labelX, labelY, FontSizeBody, ColorTextMuted)
 				}
 				tabX += w + 24
 			}
-			y += FontSizeBody + 16
+			y += contentH + 16
 		}
 
 		// Loading indicator
 		if ds.episodesLoading {
-			DrawTextCentered(dst, "Loading episodes...", float64(ScreenWidth)/2, y+50,
+			DrawTextCentered(dst, T("detail.loading_episodes"), float64(ScreenWidth)/2, y+50,
 				FontSizeBody, ColorTextSecondary)
 			return
 		}
@@ -368,7 +838,7 @@ func (ds *DetailScreen) Draw(dst *ebiten.Image) {
 			for i, ep := range ds.episodes {
 				ex, ey := ds.episodeGrid.ItemRect(i, SectionPadding, y)
 
-				ds.episodeRects[i] = ButtonRect{X: ex, Y: ey, W: PosterWidth, H: PosterHeight}
+				ds.episodeRects[i] = ButtonRect{X: ex, Y: ey, W: EpisodeCardWidth, H: EpisodeCardHeight}
 
 				isFocused := ds.focusMode == 1 && i == ds.episodeGrid.Focused
 				title := fmt.Sprintf("E%d %s", ep.IndexNumber, ep.Name)
@@ -384,8 +854,9 @@ func (ds *DetailScreen) Draw(dst *ebiten.Image) {
 					Title:    title,
 					Progress: progress,
 					Watched:  ep.Played,
+					Image:    ds.episodeStills[ep.ID],
 				}
-				drawPosterItem(dst, gi, ex, ey, isFocused)
+				drawPosterItemSized(dst, gi, ex, ey, EpisodeCardWidth, EpisodeCardHeight, isFocused)
 			}
 		}
 	}
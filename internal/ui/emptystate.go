@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// EmptyState draws a screen's "nothing here" message with a button offering
+// the obvious next step. Store one per screen, call Draw each frame the screen
+// is empty and HandleClick in Update; the screen handles OK itself.
+type EmptyState struct {
+	btnRect ButtonRect
+}
+
+const (
+	emptyBtnH    = 44.0
+	emptyBtnPadX = 28.0
+)
+
+// Draw renders message centered at (cx, y) and, when action is not empty, a
+// button labelled action below it, highlighted when focused.
+func (es *EmptyState) Draw(dst *ebiten.Image, message, action string, focused bool, cx, y float64) {
+	DrawTextCentered(dst, message, cx, y, FontSizeHeading, ColorTextSecondary)
+	if action == "" {
+		es.btnRect = ButtonRect{}
+		return
+	}
+
+	tw, _ := MeasureText(action, FontSizeBody)
+	w := tw + emptyBtnPadX*2
+	es.btnRect = ButtonRect{X: cx - w/2, Y: y + FontSizeHeading + 16, W: w, H: emptyBtnH}
+
+	r := es.btnRect
+	bg, fg := ColorSurfaceHover, ColorTextSecondary
+	if focused {
+		bg, fg = ColorPrimary, ColorText
+	}
+	vector.DrawFilledRect(dst, float32(r.X), float32(r.Y), float32(r.W), float32(r.H), bg, false)
+	DrawTextCentered(dst, action, r.X+r.W/2, r.Y+r.H/2, FontSizeBody, fg)
+}
+
+// HandleClick reports whether the click at (mx, my) hit the button last drawn.
+func (es *EmptyState) HandleClick(mx, my int) bool {
+	return es.btnRect.W > 0 && es.btnRect.Hit(mx, my)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -17,8 +18,20 @@ import (
 const (
 	evKey   = 0x01
 	keyBack = 158 // KEY_BACK (XF86Back)
+
+	evValuePress  = 1
+	evValueRepeat = 2
 )
 
+// remoteRepeats reports whether holding k should keep sending presses.
+func remoteRepeats(k RemoteKey) bool {
+	switch k {
+	case RemoteUp, RemoteDown, RemoteLeft, RemoteRight, RemoteFastForward, RemoteRewind:
+		return true
+	}
+	return false
+}
+
 // inputEventSize is the size of a Linux input_event struct (timeval + u16 + u16 + s32).
 var inputEventSize = int(unsafe.Sizeof(struct {
 	Sec, Usec int64
@@ -27,6 +40,9 @@ var inputEventSize = int(unsafe.Sizeof(struct {
 	Value     int32
 }{}))
 
+// EvdevSupported reports whether input devices are read directly.
+const EvdevSupported = true
+
 var evdevBackPressed atomic.Bool
 
 const recentEventsMax = 8
@@ -61,6 +77,7 @@ func readEvdev(path string) {
 	defer f.Close()
 
 	device := filepath.Base(path)
+	name := evdevDeviceName(device)
 	buf := make([]byte, inputEventSize)
 	for {
 		_, err := f.Read(buf)
@@ -78,6 +95,7 @@ func readEvdev(path string) {
 			ev := EvdevEvent{
 				Time:   time.Now(),
 				Device: device,
+				Name:   name,
 				Type:   typ,
 				Code:   code,
 				Value:  value,
@@ -89,15 +107,37 @@ func readEvdev(path string) {
 			}
 			recentEventsMu.Unlock()
 
-			log.Printf("evdev: key press on %s — type=%d code=%d value=%d", device, typ, code, value)
+			log.Printf("evdev: key press on %s (%s) — type=%d code=%d value=%d", device, name, typ, code, value)
+		}
+
+		if typ == evKey && (value == evValuePress || value == evValueRepeat) && isRemoteDevice(name) {
+			if k, ok := remoteKeyForCode(code); ok && (value == evValuePress || remoteRepeats(k)) {
+				pushRemote(k)
+			}
 		}
 
-		if typ == evKey && code == keyBack && value == 1 {
+		// KEY_BACK means Back on any device unless it was remapped
+		if typ == evKey && code == keyBack && value == 1 && remoteCodeIsBack(code) {
 			evdevBackPressed.Store(true)
 		}
 	}
 }
 
+func remoteCodeIsBack(code uint16) bool {
+	k, ok := remoteKeyForCode(code)
+	return !ok || k == RemoteBack
+}
+
+// evdevDeviceName returns the kernel name of an event device, e.g. "vc4-hdmi"
+// for the Raspberry Pi's CEC input.
+func evdevDeviceName(device string) string {
+	b, err := os.ReadFile(filepath.Join("/sys/class/input", device, "device", "name"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
 // EvdevBackJustPressed returns true once if the evdev KEY_BACK was pressed,
 // then resets the flag.
 func EvdevBackJustPressed() bool {
@@ -2,6 +2,9 @@
 
 package ui
 
+// EvdevSupported reports whether input devices are read directly.
+const EvdevSupported = false
+
 // EvdevBackJustPressed is a no-op on non-Linux platforms.
 func EvdevBackJustPressed() bool {
 	return false
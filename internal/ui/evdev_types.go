@@ -6,6 +6,7 @@ import "time"
 type EvdevEvent struct {
 	Time   time.Time
 	Device string // e.g. "event3"
+	Name   string // kernel device name, matched against remote_devices
 	Type   uint16
 	Code   uint16
 	Value  int32
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -13,6 +15,12 @@ type FilterOption struct {
 	Label    string
 	Options  []string
 	Selected int
+
+	// Multi pills open a checklist on Enter, so several options can be
+	// picked at once. Options[0] is the "All" entry, meaning none picked.
+	// With more than filterPickerSearchMin options, Up/Down open it too.
+	Multi  bool
+	Picked []int // indices into Options, in order; Multi only
 }
 
 // Value returns the currently selected option string.
@@ -23,6 +31,63 @@ func (fo *FilterOption) Value() string {
 	return fo.Options[fo.Selected]
 }
 
+// Values returns the picked options of a Multi pill, or the selected one of
+// other pills. The "All" entry of a Multi pill is never included.
+func (fo *FilterOption) Values() []string {
+	if !fo.Multi {
+		return []string{fo.Value()}
+	}
+	var vals []string
+	for _, i := range fo.Picked {
+		if i > 0 && i < len(fo.Options) {
+			vals = append(vals, fo.Options[i])
+		}
+	}
+	return vals
+}
+
+// display is the pill's text after its label: the value, or how many
+// options a Multi pill has picked.
+func (fo *FilterOption) display() string {
+	if fo.Multi && len(fo.Picked) > 1 {
+		return fmt.Sprintf("%d", len(fo.Picked))
+	}
+	return fo.Value()
+}
+
+// cycle moves the pill's value by step. A Multi pill then has just that
+// option picked.
+func (fo *FilterOption) cycle(step int) {
+	fo.Selected = (fo.Selected + step + len(fo.Options)) % len(fo.Options)
+	if fo.Multi {
+		fo.Picked = nil
+		if fo.Selected > 0 {
+			fo.Picked = []int{fo.Selected}
+		}
+	}
+}
+
+// setPicked replaces the picked options of a Multi pill, keeping Selected on
+// the single one (or "All") so cycling carries on from there.
+func (fo *FilterOption) setPicked(picked []int) {
+	fo.Picked = picked
+	fo.Selected = 0
+	if len(picked) == 1 {
+		fo.Selected = picked[0]
+	}
+}
+
+// ClampPicked drops picked options beyond Options, after they were replaced.
+func (fo *FilterOption) ClampPicked() {
+	kept := fo.Picked[:0]
+	for _, i := range fo.Picked {
+		if i > 0 && i < len(fo.Options) {
+			kept = append(kept, i)
+		}
+	}
+	fo.setPicked(kept)
+}
+
 // FilterBar is a horizontal bar of pill selectors plus a search input.
 type FilterBar struct {
 	Filters      []FilterOption
@@ -33,6 +98,7 @@ type FilterBar struct {
 
 	pillRects     []ButtonRect
 	searchRect    ButtonRect
+	picker        *filterPicker // open checklist of a Multi pill
 	debounceTimer *time.Timer
 	lastSearch    string
 }
@@ -45,6 +111,22 @@ func NewFilterBar(filters []FilterOption) *FilterBar {
 	}
 }
 
+// PickerOpen reports whether a Multi pill's checklist is open; it takes all
+// input until closed.
+func (fb *FilterBar) PickerOpen() bool {
+	return fb.picker != nil
+}
+
+// OpenPicker focuses pill i and opens its checklist, when it is a Multi pill.
+func (fb *FilterBar) OpenPicker(i int) bool {
+	if i < 0 || i >= len(fb.Filters) || !fb.Filters[i].Multi {
+		return false
+	}
+	fb.FocusedIndex = i
+	fb.picker = newFilterPicker(i, &fb.Filters[i])
+	return true
+}
+
 // IsSearchFocused returns true if the search input currently has focus.
 func (fb *FilterBar) IsSearchFocused() bool {
 	return fb.Active && fb.FocusedIndex >= len(fb.Filters)
@@ -52,6 +134,16 @@ func (fb *FilterBar) IsSearchFocused() bool {
 
 // Update processes input for the filter bar. Returns true if any filter value changed.
 func (fb *FilterBar) Update() bool {
+	if fb.picker != nil {
+		done, changed := fb.picker.Update()
+		if done {
+			if changed {
+				fb.Filters[fb.picker.pill].setPicked(fb.picker.picked())
+			}
+			fb.picker = nil
+		}
+		return done && changed
+	}
 	if !fb.Active {
 		return false
 	}
@@ -63,7 +155,7 @@ func (fb *FilterBar) Update() bool {
 		textChanged := fb.SearchInput.Update()
 
 		// Left at cursor position 0 → move to previous pill
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) && fb.SearchInput.Cursor == 0 {
+		if KeyJustPressed(ebiten.KeyArrowLeft) && fb.SearchInput.Cursor == 0 {
 			if len(fb.Filters) > 0 {
 				fb.FocusedIndex = len(fb.Filters) - 1
 			}
@@ -96,7 +188,7 @@ func (fb *FilterBar) Update() bool {
 		}
 
 		// Fire search on Enter immediately
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) && fb.SearchInput.Text != fb.lastSearch {
+		if KeyJustPressed(ebiten.KeyEnter) && fb.SearchInput.Text != fb.lastSearch {
 			fb.lastSearch = fb.SearchInput.Text
 			changed = true
 		}
@@ -117,14 +209,19 @@ func (fb *FilterBar) Update() bool {
 	}
 	if fb.FocusedIndex < len(fb.Filters) {
 		pill := &fb.Filters[fb.FocusedIndex]
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) || inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if pill.Multi && (KeyJustPressed(ebiten.KeyEnter) ||
+			(pill.searchable() && (KeyJustPressed(ebiten.KeyArrowUp) || KeyJustPressed(ebiten.KeyArrowDown)))) {
+			fb.OpenPicker(fb.FocusedIndex)
+			return false
+		}
+		if KeyJustPressed(ebiten.KeyArrowUp) || KeyJustPressed(ebiten.KeyEnter) {
 			// Cycle forward
-			pill.Selected = (pill.Selected + 1) % len(pill.Options)
+			pill.cycle(1)
 			changed = true
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		if KeyJustPressed(ebiten.KeyArrowDown) {
 			// Cycle backward
-			pill.Selected = (pill.Selected - 1 + len(pill.Options)) % len(pill.Options)
+			pill.cycle(-1)
 			changed = true
 		}
 	}
@@ -177,7 +274,7 @@ func (fb *FilterBar) Draw(dst *ebiten.Image, x, y float64) float64 {
 
 	for i := range fb.Filters {
 		pill := &fb.Filters[i]
-		label := pill.Label + ": " + pill.Value()
+		label := pill.Label + ": " + pill.display()
 		tw, _ := MeasureText(label, FontSizeBody)
 		pillW := tw + filterPillPadX*2
 
@@ -219,6 +316,7 @@ func (fb *FilterBar) Draw(dst *ebiten.Image, x, y float64) float64 {
 		if fb.SearchInput.Text == "" {
 			DrawText(dst, "Search...", curX+10, y+10, FontSizeBody, ColorTextMuted)
 		}
+		fb.SearchInput.DrawSelection(dst, curX+10, y+10, FontSizeBody)
 		DrawText(dst, fb.SearchInput.DisplayText(), curX+10, y+10, FontSizeBody, ColorText)
 	} else {
 		vector.DrawFilledRect(dst, float32(curX), float32(y),
@@ -235,3 +333,210 @@ func (fb *FilterBar) Draw(dst *ebiten.Image, x, y float64) float64 {
 	fb.searchRect = ButtonRect{X: curX, Y: y, W: searchW, H: filterBarHeight}
 	return filterBarHeight
 }
+
+// DrawPicker draws the open checklist below its pill. Call it after
+// everything else the screen draws, so it is on top.
+func (fb *FilterBar) DrawPicker(dst *ebiten.Image) {
+	if fb.picker == nil || fb.picker.pill >= len(fb.pillRects) {
+		return
+	}
+	r := fb.pillRects[fb.picker.pill]
+	fb.picker.Draw(dst, r.X, r.Y+r.H+8)
+}
+
+const (
+	filterPickerW    = 320.0
+	filterPickerRowH = 36.0
+	filterPickerRows = 12 // visible at once
+
+	// filterPickerSearchMin is how many options (besides "All") a Multi
+	// pill needs before its checklist gets a filter field and Up/Down on
+	// the pill open the checklist instead of cycling through them all.
+	filterPickerSearchMin = 20
+)
+
+// searchable reports whether the pill has enough options that its
+// checklist is filtered by typing rather than cycled through.
+func (fo *FilterOption) searchable() bool {
+	return fo.Multi && len(fo.Options)-1 > filterPickerSearchMin
+}
+
+// filterPicker is the checklist of a Multi pill. Up/Down move, Enter ticks
+// an option ("All" clears them), and Back closes it, applying the ticks.
+// Long checklists also have a filter field: typing narrows the options
+// shown, and Backspace edits it, closing only once it is empty.
+type filterPicker struct {
+	pill    int
+	options []string
+	checked []bool // per option; checked[0] is unused
+	index   int    // into shown
+	scroll  int    // first visible row of shown
+	changed bool
+	rects   []ButtonRect
+
+	searchable bool
+	search     TextInput
+	shown      []int // indices into options matching the search, in order
+}
+
+func newFilterPicker(pill int, fo *FilterOption) *filterPicker {
+	fp := &filterPicker{
+		pill:       pill,
+		options:    fo.Options,
+		checked:    make([]bool, len(fo.Options)),
+		searchable: fo.searchable(),
+	}
+	for _, i := range fo.Picked {
+		if i > 0 && i < len(fp.checked) {
+			fp.checked[i] = true
+		}
+	}
+	fp.filter()
+	return fp
+}
+
+// filter rebuilds shown from the search text and brings the cursor back to
+// the top. "All" is only offered while the search is empty.
+func (fp *filterPicker) filter() {
+	fp.shown = fp.shown[:0]
+	query := strings.ToLower(strings.TrimSpace(fp.search.Text))
+	for i, opt := range fp.options {
+		if query == "" || (i > 0 && strings.Contains(strings.ToLower(opt), query)) {
+			fp.shown = append(fp.shown, i)
+		}
+	}
+	fp.index = 0
+	fp.scroll = 0
+}
+
+// picked returns the ticked option indices in order.
+func (fp *filterPicker) picked() []int {
+	var picked []int
+	for i := 1; i < len(fp.checked); i++ {
+		if fp.checked[i] {
+			picked = append(picked, i)
+		}
+	}
+	return picked
+}
+
+func (fp *filterPicker) toggle(i int) {
+	if i == 0 {
+		clear(fp.checked)
+	} else {
+		fp.checked[i] = !fp.checked[i]
+	}
+	fp.changed = true
+}
+
+// toggleShown ticks the option at position pos of shown.
+func (fp *filterPicker) toggleShown(pos int) {
+	if pos >= 0 && pos < len(fp.shown) {
+		fp.toggle(fp.shown[pos])
+	}
+}
+
+// Update handles input for one frame and reports whether the checklist
+// should close and whether any tick changed.
+func (fp *filterPicker) Update() (done, changed bool) {
+	if mx, my, clicked := MouseJustClicked(); clicked {
+		for i, r := range fp.rects {
+			if r.Hit(mx, my) {
+				fp.index = fp.scroll + i
+				fp.toggleShown(fp.index)
+				return false, false
+			}
+		}
+		return true, fp.changed // click outside closes
+	}
+	if _, wy := ebiten.Wheel(); wy != 0 {
+		fp.scroll = max(0, min(fp.scroll-int(wy), len(fp.shown)-filterPickerRows))
+	}
+
+	dir, enter, back := InputState()
+	if fp.searchable {
+		// Backspace belongs to the filter field until it is empty
+		empty := fp.search.Text == ""
+		if fp.search.Update() {
+			fp.filter()
+		}
+		back = KeyJustPressed(ebiten.KeyEscape) ||
+			inpututil.IsMouseButtonJustPressed(ebiten.MouseButton3) ||
+			EvdevBackJustPressed() ||
+			(empty && inpututil.IsKeyJustPressed(ebiten.KeyBackspace))
+	}
+	if back {
+		return true, fp.changed
+	}
+	switch dir {
+	case DirUp:
+		if fp.index > 0 {
+			fp.index--
+		}
+	case DirDown:
+		if fp.index < len(fp.shown)-1 {
+			fp.index++
+		}
+	}
+	if fp.index < fp.scroll {
+		fp.scroll = fp.index
+	} else if fp.index >= fp.scroll+filterPickerRows {
+		fp.scroll = fp.index - filterPickerRows + 1
+	}
+	if enter || (!fp.searchable && KeyJustPressed(ebiten.KeySpace)) {
+		fp.toggleShown(fp.index)
+	}
+	return false, false
+}
+
+func (fp *filterPicker) Draw(dst *ebiten.Image, x, y float64) {
+	rows := min(len(fp.shown)-fp.scroll, filterPickerRows)
+	h := float64(max(rows, 1))*filterPickerRowH + filterBarPadding*2
+	if fp.searchable {
+		h += filterPickerRowH
+	}
+	vector.DrawFilledRect(dst, float32(x), float32(y), filterPickerW, float32(h), ColorSurface, false)
+	vector.StrokeRect(dst, float32(x), float32(y), filterPickerW, float32(h), 2, ColorPrimary, false)
+
+	rx := x + filterBarPadding
+	rw := filterPickerW - filterBarPadding*2
+	top := y + filterBarPadding
+	if fp.searchable {
+		vector.DrawFilledRect(dst, float32(rx), float32(top), float32(rw), filterPickerRowH-4, ColorSurfaceHover, false)
+		ty := top + (filterPickerRowH-4-FontSizeBody)/2
+		if fp.search.Text == "" {
+			DrawText(dst, "Type to filter...", rx+10, ty, FontSizeBody, ColorTextMuted)
+		}
+		fp.search.DrawSelection(dst, rx+10, ty, FontSizeBody)
+		DrawText(dst, fp.search.DisplayText(), rx+10, ty, FontSizeBody, ColorText)
+		top += filterPickerRowH
+	}
+	if len(fp.shown) == 0 {
+		DrawText(dst, "No matches", rx+10, top+(filterPickerRowH-FontSizeBody)/2, FontSizeBody, ColorTextMuted)
+	}
+
+	fp.rects = fp.rects[:0]
+	for row := range rows {
+		i := fp.shown[fp.scroll+row]
+		ry := top + float64(row)*filterPickerRowH
+		fp.rects = append(fp.rects, ButtonRect{X: rx, Y: ry, W: rw, H: filterPickerRowH})
+
+		clr := ColorTextSecondary
+		if fp.scroll+row == fp.index {
+			vector.DrawFilledRect(dst, float32(rx), float32(ry), float32(rw), filterPickerRowH, ColorPrimary, false)
+			clr = ColorBackground
+		}
+		// Check box; "All" is ticked while nothing else is
+		box := float32(16)
+		bx, by := float32(rx+10), float32(ry+(filterPickerRowH-16)/2)
+		checked := fp.checked[i]
+		if i == 0 {
+			checked = len(fp.picked()) == 0
+		}
+		vector.StrokeRect(dst, bx, by, box, box, 1.5, clr, false)
+		if checked {
+			drawCheckmark(dst, bx+box/2, by+box/2, box*0.35, clr)
+		}
+		DrawText(dst, truncateText(fp.options[i], rw-50, FontSizeBody), rx+40, ry+(filterPickerRowH-FontSizeBody)/2, FontSizeBody, clr)
+	}
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// systemFallbackFonts lists, per script, system font files that may provide
+// glyphs missing from the built-in Latin font. The first file found in each
+// group is used.
+func systemFallbackFonts() [][]string {
+	switch runtime.GOOS {
+	case "windows":
+		dir := filepath.Join(os.Getenv("WINDIR"), "Fonts")
+		return [][]string{
+			// CJK
+			{filepath.Join(dir, "YuGothR.ttc"), filepath.Join(dir, "msgothic.ttc"), filepath.Join(dir, "msyh.ttc")},
+			// Korean
+			{filepath.Join(dir, "malgun.ttf")},
+			// Arabic, Hebrew
+			{filepath.Join(dir, "segoeui.ttf"), filepath.Join(dir, "arial.ttf")},
+		}
+	default:
+		return [][]string{
+			{ // CJK
+				"/usr/share/fonts/opentype/noto/NotoSansCJK-Regular.ttc",
+				"/usr/share/fonts/noto-cjk/NotoSansCJK-Regular.ttc",
+				"/usr/share/fonts/google-noto-cjk/NotoSansCJK-Regular.ttc",
+				"/usr/share/fonts/truetype/droid/DroidSansFallbackFull.ttf",
+				"/usr/share/fonts/wenquanyi/wqy-microhei/wqy-microhei.ttc",
+			},
+			{ // Arabic, Hebrew and more
+				"/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf",
+				"/usr/share/fonts/TTF/DejaVuSans.ttf",
+				"/usr/share/fonts/dejavu/DejaVuSans.ttf",
+			},
+		}
+	}
+}
+
+// LoadFallbackFonts adds fonts used for glyphs the built-in font lacks. With
+// no paths, common system fonts for CJK and right-to-left scripts are used.
+// Call after InitFonts and before drawing.
+func LoadFallbackFonts(paths []string) {
+	if len(paths) == 0 {
+		for _, group := range systemFallbackFonts() {
+			for _, p := range group {
+				if _, err := os.Stat(p); err != nil {
+					continue
+				}
+				if addFallbackFont(p) {
+					break
+				}
+			}
+		}
+		return
+	}
+	for _, p := range paths {
+		addFallbackFont(p)
+	}
+}
+
+// addFallbackFont loads a TTF/OTF file, or the first font of a TTC/OTC
+// collection, and reports whether it was added.
+func addFallbackFont(path string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("Fallback font %s: %v", path, err)
+		return false
+	}
+	var src *text.GoTextFaceSource
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ttc", ".otc":
+		var srcs []*text.GoTextFaceSource
+		if srcs, err = text.NewGoTextFaceSourcesFromCollection(bytes.NewReader(data)); err == nil {
+			src = srcs[0]
+		}
+	default:
+		src, err = text.NewGoTextFaceSource(bytes.NewReader(data))
+	}
+	if err != nil {
+		log.Printf("Fallback font %s: %v", path, err)
+		return false
+	}
+	fontSources = append(fontSources, src)
+	clear(fontFaces)
+	log.Printf("Loaded fallback font %s", path)
+	return true
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// regionFormat is how numbers, dates and times are written in a region.
+type regionFormat struct {
+	thousands string // item counts, e.g. "1,234"
+	decimal   string // file sizes, e.g. "4.2 GB"
+	clock     string // time.Format layout of the clock
+	date      string // time.Format layout of a full date
+	shortDate string // time.Format layout of a day and month
+}
+
+// regionFormats maps locale codes to their formats. A language code alone
+// stands for its most common region.
+var regionFormats = map[string]regionFormat{
+	"en-US": {",", ".", "3:04 PM", "Jan 2, 2006", "Jan 2"},
+	"en-GB": {",", ".", "15:04", "2 Jan 2006", "2 Jan"},
+	"nl-NL": {".", ",", "15:04", "2-1-2006", "2-1"},
+	"nl-BE": {".", ",", "15:04", "2/01/2006", "2/01"},
+	"de-DE": {".", ",", "15:04", "2.1.2006", "2.1."},
+	"de-CH": {"'", ".", "15:04", "2.1.2006", "2.1."},
+	"fr-FR": {" ", ",", "15:04", "02/01/2006", "02/01"},
+	"fr-CA": {" ", ",", "15 h 04", "2006-01-02", "01-02"},
+}
+
+var regionDefaults = map[string]string{"en": "en-US", "nl": "nl-NL", "de": "de-DE", "fr": "fr-FR"}
+
+var (
+	localeSetting string // ui.locale; "" follows the interface language
+	activeRegion  atomic.Pointer[regionFormat]
+)
+
+// SetLocale picks the number, date and clock formats for a locale code such
+// as "en-GB" or "nl". An empty code follows the interface language; unknown
+// regions fall back to their language's default format.
+func SetLocale(code string) {
+	localeSetting = code
+	applyLocale()
+}
+
+// applyLocale looks up the formats for the locale setting, or for the active
+// language when it is empty. SetLanguage calls it too.
+func applyLocale() {
+	code := strings.ReplaceAll(localeSetting, "_", "-")
+	if code == "" {
+		code = Language().Code
+	}
+	f, ok := regionFormats[code]
+	if !ok {
+		lang, _, _ := strings.Cut(code, "-")
+		f, ok = regionFormats[regionDefaults[strings.ToLower(lang)]]
+	}
+	if !ok {
+		f = regionFormats["en-US"]
+	}
+	activeRegion.Store(&f)
+}
+
+func region() *regionFormat {
+	return activeRegion.Load()
+}
+
+// FormatCount formats n with the locale's thousands separator, e.g. "1,234".
+func FormatCount(n int) string {
+	s := strconv.Itoa(n)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	var b strings.Builder
+	if neg {
+		b.WriteByte('-')
+	}
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteString(region().thousands)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FormatClock formats the time of day in the locale's 12 or 24 hour clock.
+func FormatClock(t time.Time) string {
+	return t.Format(region().clock)
+}
+
+// FormatDate formats t as a full date in the locale's order.
+func FormatDate(t time.Time) string {
+	return t.Format(region().date)
+}
+
+// FormatShortDate formats t as a day and month without the year.
+func FormatShortDate(t time.Time) string {
+	return t.Format(region().shortDate)
+}
+
+// FormatTimecode formats ticks as "H:MM:SS", or "M:SS" under an hour.
+func FormatTimecode(ticks int64) string {
+	total := max(ticks/10_000_000, 0)
+	h, m, s := total/3600, total%3600/60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+// FormatFileSize formats a size in bytes as e.g. "4.2 GB", with the locale's
+// decimal separator.
+func FormatFileSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	size := strings.Replace(fmt.Sprintf("%.1f", float64(bytes)/float64(div)), ".", region().decimal, 1)
+	return fmt.Sprintf("%s %cB", size, "KMGTPE"[exp])
+}
+
+// FormatRuntime formats a runtime as e.g. "1h 58m".
+func FormatRuntime(ticks int64) string {
+	minutes := ticks / 600_000_000
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", minutes/60, minutes%60)
+}
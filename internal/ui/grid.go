@@ -5,6 +5,7 @@ import (
 	"image"
 	"image/color"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -22,10 +23,24 @@ type GridItem struct {
 	Progress float64 // 0.0 to 1.0, playback progress
 	Watched  bool
 	Rating   float64 // TMDB/community rating (0 = no rating)
+	// Unwatched episodes for series/seasons (0 = none or not applicable)
+	UnplayedCount int
 	// Jellyseerr request status: 0=none, 2=pending, 3=partial, 4=processing, 5=available
 	RequestStatus int
+	// BlurHash preview drawn until Image has loaded ("" = plain placeholder)
+	BlurHash string
+	// Jellyfin metadata used by the context menu
+	Type     string // Movie, Series, Episode, Season, etc.
+	SeriesID string
+	Favorite bool
+	TMDBID   string
+	// Picked in a multi-select mode
+	Selected bool
+	// Accent color of the poster, tinting its focus highlight (nil = none)
+	AccentColor color.Color
 	// Set by the grid during layout
-	X, Y float64
+	X, Y     float64
+	onScreen bool // drawn this frame; X and Y are stale otherwise
 }
 
 // PosterGrid is a horizontally scrolling row of poster items.
@@ -37,6 +52,10 @@ type PosterGrid struct {
 	targetOffsetX float64
 
 	Active bool // whether this row currently has focus
+	// AccentLabel tints the label with the first poster's AccentColor
+	AccentLabel bool
+
+	zoom focusZoomState
 }
 
 func NewPosterGrid(label string) *PosterGrid {
@@ -83,11 +102,12 @@ func (pg *PosterGrid) AnimateScroll() {
 	pg.OffsetX = Lerp(pg.OffsetX, pg.targetOffsetX, ScrollAnimSpeed)
 }
 
-// HandleClick checks if (mx, my) hits any item and returns its index.
+// HandleClick checks if (mx, my) hits any item drawn in the last frame,
+// poster or labels, and returns its index.
 func (pg *PosterGrid) HandleClick(mx, my int) (clickedIndex int, ok bool) {
 	for i := range pg.Items {
 		item := &pg.Items[i]
-		if PointInRect(mx, my, item.X, item.Y, PosterWidth, PosterHeight) {
+		if item.onScreen && PointInHitRect(mx, my, item.X, item.Y, PosterWidth, PosterItemHeight) {
 			return i, true
 		}
 	}
@@ -98,7 +118,11 @@ func (pg *PosterGrid) Draw(dst *ebiten.Image, baseX, baseY float64) float64 {
 	pg.AnimateScroll()
 
 	// Section label
-	DrawText(dst, pg.Label, baseX, baseY, FontSizeHeading, ColorText)
+	var labelClr color.Color = ColorText
+	if pg.AccentLabel && len(pg.Items) > 0 && pg.Items[0].AccentColor != nil {
+		labelClr = pg.Items[0].AccentColor
+	}
+	DrawText(dst, pg.Label, baseX, baseY, FontSizeHeading, labelClr)
 	baseY += SectionTitleH
 
 	// Create a clipping sub-image for the poster row
@@ -113,6 +137,7 @@ func (pg *PosterGrid) Draw(dst *ebiten.Image, baseX, baseY float64) float64 {
 		iy := baseY + PosterFocusPad
 
 		// Skip offscreen items
+		item.onScreen = false
 		if ix+PosterWidth < baseX-PosterGap || ix > float64(ScreenWidth) {
 			if ix > float64(ScreenWidth) {
 				hasRight = true
@@ -122,9 +147,18 @@ func (pg *PosterGrid) Draw(dst *ebiten.Image, baseX, baseY float64) float64 {
 
 		item.X = ix
 		item.Y = iy
+		item.onScreen = true
 
-		isFocused := pg.Active && i == pg.Focused
-		drawPosterItem(dst, *item, ix, iy, isFocused)
+		// The focused poster is drawn last so a zoom overlaps its neighbours
+		if !(pg.Active && i == pg.Focused) {
+			drawPosterItem(dst, *item, ix, iy, false)
+		}
+	}
+	if pg.Active && pg.Focused < len(pg.Items) && pg.Items[pg.Focused].onScreen {
+		item := pg.Items[pg.Focused]
+		drawPosterItemZoomed(dst, item, item.X, item.Y, PosterWidth, PosterHeight, true, pg.zoom.step(pg.Focused))
+	} else {
+		pg.zoom.reset()
 	}
 
 	// Check if last item extends beyond view
@@ -160,6 +194,9 @@ func truncateText(s string, maxWidth float64, fontSize float64) string {
 		return s
 	}
 	for i := len(s) - 1; i > 0; i-- {
+		if !utf8.RuneStart(s[i]) {
+			continue
+		}
 		candidate := s[:i] + "…"
 		w, _ = MeasureText(candidate, fontSize)
 		if w <= maxWidth {
@@ -213,7 +250,7 @@ func ImageFromRGBA(rgba *image.RGBA) *ebiten.Image {
 }
 
 // drawRequestBadge draws a full-width status banner at the bottom of a poster.
-func drawRequestBadge(dst *ebiten.Image, status int, x, y float64) {
+func drawRequestBadge(dst *ebiten.Image, status int, x, y, w, h float64) {
 	label := ""
 	switch status {
 	case 2: // pending
@@ -229,10 +266,28 @@ func drawRequestBadge(dst *ebiten.Image, status int, x, y float64) {
 	}
 	badgeColor := statusBadgeColor(status)
 	bh := FontSizeSmall + 8.0
-	bannerY := y + PosterHeight - bh
+	bannerY := y + h - bh
 	vector.DrawFilledRect(dst, float32(x), float32(bannerY),
-		float32(PosterWidth), float32(bh), badgeColor, false)
-	DrawTextCentered(dst, label, x+PosterWidth/2, bannerY+bh/2, FontSizeSmall, ColorText)
+		float32(w), float32(bh), badgeColor, false)
+	DrawTextCentered(dst, label, x+w/2, bannerY+bh/2, FontSizeSmall, ColorText)
+}
+
+// drawUnplayedBadge draws the unwatched-episode count as a pill in the top-right corner.
+func drawUnplayedBadge(dst *ebiten.Image, count int, x, y, w float64) {
+	label := fmt.Sprintf("%d", count)
+	if count > 99 {
+		label = "99+"
+	}
+	tw, _ := MeasureText(label, FontSizeSmall)
+	bh := FontSizeSmall + 6.0
+	bw := tw + 12
+	if bw < bh {
+		bw = bh // keep single digits circular-ish
+	}
+	bx := x + w - bw - 4
+	by := y + 4
+	vector.DrawFilledRect(dst, float32(bx), float32(by), float32(bw), float32(bh), ColorPrimary, false)
+	DrawTextCentered(dst, label, bx+bw/2, by+bh/2, FontSizeSmall, ColorText)
 }
 
 // drawRatingBadge draws a small pill badge in the top-left corner with a vector star + "7.5" format.
@@ -263,52 +318,137 @@ func drawRatingBadge(dst *ebiten.Image, rating float64, x, y float64) {
 }
 
 // drawPosterItem draws a single poster grid item with all decorations:
-// focus border, image/placeholder, progress bar, watched badge, request badge, rating, title, subtitle.
+// focus border, image/placeholder, progress bar, watched/unwatched-count badge, request badge, rating, title, subtitle.
 func drawPosterItem(dst *ebiten.Image, item GridItem, x, y float64, focused bool) {
-	// Focus highlight
-	if focused {
-		vector.DrawFilledRect(dst,
+	drawPosterItemSized(dst, item, x, y, PosterWidth, PosterHeight, focused)
+}
+
+// drawPosterItemSized is drawPosterItem with a poster of w×h instead of the
+// default size, for grids with a configured column count.
+func drawPosterItemSized(dst *ebiten.Image, item GridItem, x, y, w, h float64, focused bool) {
+	drawPosterItemZoomed(dst, item, x, y, w, h, focused, 1)
+}
+
+// focusZoomState eases a grid's focused poster up to its zoomed size when
+// PosterFocusStyle is FocusZoom.
+type focusZoomState struct {
+	index    int     // item the animation is for
+	progress float64 // 0 = normal size, 1 = fully zoomed
+}
+
+// step advances the animation by a frame for focused item i and returns the
+// zoom progress for drawPosterItemZoomed. Call from Draw.
+func (z *focusZoomState) step(i int) float64 {
+	if !AnimateFocusZoom {
+		return 1
+	}
+	if i != z.index {
+		z.index = i
+		z.progress = 0
+	}
+	z.progress = Lerp(z.progress, 1, FocusAnimSpeed*2)
+	return z.progress
+}
+
+// reset restarts the animation, for when the grid loses focus.
+func (z *focusZoomState) reset() {
+	z.progress = 0
+}
+
+// drawPosterItemZoomed is drawPosterItemSized with the focus zoom at zoom
+// (0–1) of the full focusZoom growth.
+func drawPosterItemZoomed(dst *ebiten.Image, item GridItem, x, y, w, h float64, focused bool, zoom float64) {
+	// The labels stay in place when a zoomed poster grows over its padding
+	labelX, labelY, labelW := x, y+h, w
+	if focused && PosterFocusStyle == FocusZoom {
+		dx, dy := w*focusZoom/2*zoom, h*focusZoom/2*zoom
+		x, y, w, h = x-dx, y-dy, w+dx*2, h+dy*2
+	} else if focused {
+		// Focus highlight, its corners concentric with the poster's
+		r := CornerRadius
+		if r > 0 {
+			r += PosterFocusPad
+		}
+		var frame color.Color = ColorFocusBorder
+		if item.AccentColor != nil {
+			frame = item.AccentColor
+		}
+		DrawFilledRoundRect(dst,
 			float32(x-PosterFocusPad), float32(y-PosterFocusPad),
-			float32(PosterWidth+PosterFocusPad*2), float32(PosterHeight+PosterFocusPad*2),
-			ColorFocusBorder, false)
+			float32(w+PosterFocusPad*2), float32(h+PosterFocusPad*2),
+			float32(r), frame)
 	}
 
-	// Poster image or placeholder
-	if item.Image != nil {
-		DrawImageCover(dst, item.Image, x, y, PosterWidth, PosterHeight)
-	} else {
-		vector.DrawFilledRect(dst, float32(x), float32(y),
-			float32(PosterWidth), float32(PosterHeight),
-			ColorSurface, false)
-		DrawTextCentered(dst, item.Title,
-			x+PosterWidth/2, y+PosterHeight/2,
-			FontSizeSmall, ColorTextMuted)
-	}
-
-	// Progress bar at bottom of poster
-	if item.Progress > 0 && item.Progress < 1.0 {
-		barH := float32(4)
-		barY := float32(y + PosterHeight - float64(barH))
-		vector.DrawFilledRect(dst, float32(x), barY,
-			float32(PosterWidth), barH,
-			color.RGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x80}, false)
-		vector.DrawFilledRect(dst, float32(x), barY,
-			float32(float64(PosterWidth)*item.Progress), barH,
-			ColorPrimary, false)
+	// Poster image or placeholder and the bars along its bottom edge,
+	// clipped to the rounded corners
+	drawRounded(dst, x, y, w, h, CornerRadius, func(dst *ebiten.Image, x, y float64) {
+		if item.Image != nil {
+			DrawImageCover(dst, item.Image, x, y, w, h)
+		} else if preview := blurHashImage(item.BlurHash); preview != nil {
+			DrawImageCover(dst, preview, x, y, w, h)
+		} else {
+			vector.DrawFilledRect(dst, float32(x), float32(y),
+				float32(w), float32(h),
+				ColorSurface, false)
+			DrawTextCentered(dst, item.Title,
+				x+w/2, y+h/2,
+				FontSizeSmall, ColorTextMuted)
+		}
+
+		// Watched and not focused: fade toward the background, as if drawn
+		// at lower opacity
+		if DimWatched && item.Watched && !focused {
+			bg := color.RGBA{ // premultiplied
+				R: uint8(float64(ColorBackground.R) * watchedDim),
+				G: uint8(float64(ColorBackground.G) * watchedDim),
+				B: uint8(float64(ColorBackground.B) * watchedDim),
+				A: uint8(255 * watchedDim),
+			}
+			vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), float32(h), bg, false)
+		}
+
+		// Progress bar at bottom of poster
+		if item.Progress > 0 && item.Progress < 1.0 {
+			barH := float32(4)
+			barY := float32(y + h - float64(barH))
+			vector.DrawFilledRect(dst, float32(x), barY,
+				float32(w), barH,
+				color.RGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x80}, false)
+			vector.DrawFilledRect(dst, float32(x), barY,
+				float32(w*item.Progress), barH,
+				ColorPrimary, false)
+		}
+
+		// Request status badge (full-width banner at bottom of poster)
+		if item.RequestStatus > 0 && item.Progress == 0 {
+			drawRequestBadge(dst, item.RequestStatus, x, y, w, h)
+		}
+
+		// Selected: dimmed under a frame in the accent color, unlike the
+		// focus highlight around the poster
+		if item.Selected {
+			vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), float32(h),
+				color.RGBA{A: 0x80}, false)
+			vector.StrokeRect(dst, float32(x)+2, float32(y)+2, float32(w)-4, float32(h)-4, 4, ColorPrimary, false)
+		}
+	})
+	if item.Selected {
+		r := float32(18)
+		cx, cy := float32(x+w/2), float32(y+h/2)
+		vector.DrawFilledCircle(dst, cx, cy, r, ColorPrimary, true)
+		drawCheckmark(dst, cx, cy, r*0.5, ColorText)
 	}
 
 	// Watched checkmark badge (top-right corner with green circle)
 	if item.Watched {
 		badgeR := float32(10)
-		badgeCX := float32(x+PosterWidth) - badgeR - 4
+		badgeCX := float32(x+w) - badgeR - 4
 		badgeCY := float32(y) + badgeR + 4
 		vector.DrawFilledCircle(dst, badgeCX, badgeCY, badgeR, ColorSuccess, false)
 		drawCheckmark(dst, badgeCX, badgeCY, badgeR*0.5, ColorText)
-	}
-
-	// Request status badge (full-width banner at bottom of poster)
-	if item.RequestStatus > 0 && item.Progress == 0 {
-		drawRequestBadge(dst, item.RequestStatus, x, y)
+	} else if item.UnplayedCount > 0 {
+		// Unwatched episode count (same corner, series/seasons only)
+		drawUnplayedBadge(dst, item.UnplayedCount, x, y, w)
 	}
 
 	// Rating badge (top-left corner)
@@ -321,13 +461,13 @@ func drawPosterItem(dst *ebiten.Image, item GridItem, x, y float64, focused bool
 	if focused {
 		titleColor = ColorText
 	}
-	title := truncateText(item.Title, PosterWidth, FontSizeSmall)
-	DrawText(dst, title, x, y+PosterHeight+6, FontSizeSmall, titleColor)
+	title := truncateText(item.Title, labelW, FontSizeSmall)
+	DrawText(dst, title, labelX, labelY+6, FontSizeSmall, titleColor)
 
 	// Subtitle below title
 	if item.Subtitle != "" {
-		sub := truncateText(item.Subtitle, PosterWidth, FontSizeCaption)
-		DrawText(dst, sub, x, y+PosterHeight+6+FontSizeSmall+4, FontSizeCaption, ColorTextMuted)
+		sub := truncateText(item.Subtitle, labelW, FontSizeCaption)
+		DrawText(dst, sub, labelX, labelY+6+FontSizeSmall+4, FontSizeCaption, ColorTextMuted)
 	}
 }
 
@@ -335,10 +475,20 @@ func drawPosterItem(dst *ebiten.Image, item GridItem, x, y float64, focused bool
 // This handles episode title logic, progress calculation, and watched state.
 func GridItemFromMediaItem(item jellyfin.MediaItem) GridItem {
 	gi := GridItem{
-		ID:      item.ID,
-		Title:   item.Name,
-		Watched: item.Played,
-		Rating:  float64(item.CommunityRating),
+		ID:       item.ID,
+		Title:    item.Name,
+		Watched:  item.Played,
+		Rating:   float64(item.CommunityRating),
+		Type:     item.Type,
+		SeriesID: item.SeriesID,
+		TMDBID:   item.ProviderIDs["Tmdb"],
+		BlurHash: item.PosterBlurHash,
+	}
+	if item.UserData != nil {
+		gi.Favorite = item.UserData.IsFavorite
+	}
+	if (item.Type == "Series" || item.Type == "Season") && !item.Played {
+		gi.UnplayedCount = item.UnplayedItemCount
 	}
 
 	// For episodes, show the series name as the title and episode info as subtitle
@@ -381,14 +531,17 @@ func LoadGridItemImages(client *jellyfin.Client, imgCache *cache.ImageCache, ite
 		url := client.GetPosterURL(posterID)
 		if img := imgCache.Get(url); img != nil {
 			(*items)[i].Image = img
+			(*items)[i].AccentColor = accentColor(imgCache, url)
 		} else {
 			itemID := item.ID
 			imgCache.LoadAsync(url, func(img *ebiten.Image) {
+				accent := accentColor(imgCache, url)
 				mu.Lock()
 				defer mu.Unlock()
 				for j := range *items {
 					if (*items)[j].ID == itemID {
 						(*items)[j].Image = img
+						(*items)[j].AccentColor = accent
 						break
 					}
 				}
@@ -397,6 +550,28 @@ func LoadGridItemImages(client *jellyfin.Client, imgCache *cache.ImageCache, ite
 	}
 }
 
+// accentColor returns the accent color of the image at url, or nil when the
+// cache has none for it.
+func accentColor(imgCache *cache.ImageCache, url string) color.Color {
+	if c, ok := imgCache.AccentColor(url); ok {
+		return c
+	}
+	return nil
+}
+
+// setImageFor gives img to the items that are key, matched by ID and title,
+// wherever they are now. Poster loads finish out of order, after the items
+// may have been rebuilt or paged, so their index at load time can't be
+// trusted. The title tells apart a movie and a show sharing a TMDB ID.
+// The caller must hold the mutex guarding items.
+func setImageFor(items []GridItem, key GridItem, img *ebiten.Image) {
+	for i := range items {
+		if items[i].ID == key.ID && items[i].Title == key.Title {
+			items[i].Image = img
+		}
+	}
+}
+
 // ToggleWatched fires the appropriate MarkPlayed/MarkUnplayed API call in the background
 // and flips the local played state. Returns the new played state.
 // The caller must hold any necessary mutex before calling this.
@@ -409,6 +584,14 @@ func ToggleWatched(client *jellyfin.Client, itemID string, played bool) bool {
 	return !played
 }
 
+// ToggleFavorite fires the SetFavorite API call in the background and flips the
+// local favorite state. Returns the new favorite state.
+// The caller must hold any necessary mutex before calling this.
+func ToggleFavorite(client *jellyfin.Client, itemID string, favorite bool) bool {
+	go client.SetFavorite(itemID, !favorite)
+	return !favorite
+}
+
 // statusBadgeColor returns the badge background color for a media status.
 func statusBadgeColor(status int) color.RGBA {
 	switch status {
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestSetImageForOutOfOrder(t *testing.T) {
+	movie := GridItem{ID: "603", Title: "The Matrix"}
+	show := GridItem{ID: "603", Title: "Matrix"} // same TMDB ID, other media type
+	other := GridItem{ID: "550", Title: "Fight Club"}
+	movieImg, showImg, otherImg := &ebiten.Image{}, &ebiten.Image{}, &ebiten.Image{}
+
+	// Loads start for a page of other, movie, show. The grid is then
+	// rebuilt (re-sorted and paged) before any finishes, so nothing is
+	// where it was.
+	items := []GridItem{show, {ID: "13", Title: "Forrest Gump"}, movie, other}
+
+	// The loads finish in another order than they started
+	setImageFor(items, show, showImg)
+	setImageFor(items, other, otherImg)
+	setImageFor(items, movie, movieImg)
+
+	want := []*ebiten.Image{showImg, nil, movieImg, otherImg}
+	for i, item := range items {
+		if item.Image != want[i] {
+			t.Errorf("items[%d] (%s) got the wrong image", i, item.Title)
+		}
+	}
+
+	// A load for an item that has since gone leaves the grid untouched
+	setImageFor(items, GridItem{ID: "680", Title: "Pulp Fiction"}, &ebiten.Image{})
+	for i, item := range items {
+		if item.Image != want[i] {
+			t.Errorf("after a stale load, items[%d] (%s) changed", i, item.Title)
+		}
+	}
+}
@@ -1,12 +1,16 @@
 package ui
 
 import (
+	"image/color"
 	"log"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/depeter/jellycouch/internal/cache"
 	"github.com/depeter/jellycouch/internal/jellyfin"
@@ -16,8 +20,12 @@ import (
 // that support "See All" browsing.
 type sectionMeta struct {
 	IsLibrary bool
+	IsResume  bool // Continue Watching — items can be removed
 	ParentID  string
 	Title     string
+
+	// Library sections load their items once they near the screen
+	Pending bool // not requested yet
 }
 
 // HomeScreen displays library sections: Continue Watching, Next Up, and each library's latest items.
@@ -38,17 +46,45 @@ type HomeScreen struct {
 	// Per-section metadata for library browsing
 	sectionMeta []sectionMeta
 
+	// Libraries whose "Latest" row was left out at the last load, excluded
+	// on the server or through IsLatestHidden
+	latestExcluded map[string]bool
+	serverExcluded map[string]bool
+
+	// Context menu for the focused/right-clicked item
+	contextMenu    *ContextMenu
+	contextSection int
+
 	// Callbacks
 	OnItemSelected    func(item jellyfin.MediaItem)
 	OnLibraryBrowse   func(parentID, title string)
 	OnAuthError       func()
+	OnPlay            func(item jellyfin.MediaItem, resumeTicks int64)
+	OnRequest4K       func(tmdbID int, mediaType, title string)
+
+	// IsLatestHidden reports whether the user hid a library's "Latest" row
+	// in settings. Nil shows every row the server doesn't exclude.
+	IsLatestHidden func(viewID string) bool
 
 	authFailed bool
 	errDisplay ErrorDisplay
+	emptyState EmptyState
+
+	// Ambient background: the focused item's backdrop, crossfaded in
+	ambientWant  string    // backdrop URL of the focused item
+	ambientSince time.Time // when focus settled on ambientWant
+	ambientReq   string    // backdrop URL last requested
+	ambient      *ebiten.Image
+	ambientPrev  *ebiten.Image // fading out
+	ambientFade  float64       // 0 = ambientPrev, 1 = ambient
 
 	mu sync.Mutex
 }
 
+// ambientDelay is how long focus has to rest on an item before its backdrop
+// is loaded, so scrolling along a row doesn't fetch every one.
+const ambientDelay = 400 * time.Millisecond
+
 func NewHomeScreen(client *jellyfin.Client, imgCache *cache.ImageCache) *HomeScreen {
 	return &HomeScreen{
 		client:   client,
@@ -59,14 +95,48 @@ func NewHomeScreen(client *jellyfin.Client, imgCache *cache.ImageCache) *HomeScr
 func (hs *HomeScreen) Name() string { return "Home" }
 
 func (hs *HomeScreen) OnEnter() {
+	hs.mu.Lock()
 	if !hs.loaded && !hs.loading {
 		hs.loading = true
 		go hs.loadData()
+	} else if hs.loaded && hs.latestChanged() {
+		hs.reload()
 	}
+	hs.mu.Unlock()
+	cachedPlaylists(hs.client) // warm the "Add to Playlist" menu
 }
 
 func (hs *HomeScreen) OnExit() {}
 
+// latestHidden reports whether viewID's "Latest" row should be left out.
+// Caller must hold hs.mu.
+func (hs *HomeScreen) latestHidden(viewID string) bool {
+	return hs.serverExcluded[viewID] || (hs.IsLatestHidden != nil && hs.IsLatestHidden(viewID))
+}
+
+// latestChanged reports whether a library was hidden or shown in settings
+// since the sections were loaded. Caller must hold hs.mu.
+func (hs *HomeScreen) latestChanged() bool {
+	for _, view := range hs.libraryViews {
+		if hs.latestHidden(view.ID) != hs.latestExcluded[view.ID] {
+			return true
+		}
+	}
+	return false
+}
+
+// reload fetches every section again, after a failed or empty load.
+// Caller must hold hs.mu.
+func (hs *HomeScreen) reload() {
+	if hs.loading {
+		return
+	}
+	hs.loaded = false
+	hs.loading = true
+	hs.loadError = ""
+	go hs.loadData()
+}
+
 func (hs *HomeScreen) loadData() {
 	type sectionResult struct {
 		grid  *PosterGrid
@@ -105,9 +175,9 @@ func (hs *HomeScreen) loadData() {
 			return
 		}
 		if len(items) > 0 {
-			grid := NewPosterGrid("Continue Watching")
+			grid := NewPosterGrid(T("home.continue_watching"))
 			hs.convertItemsForGrid(grid, items)
-			addResult(sectionResult{grid: grid, meta: sectionMeta{}, order: 0})
+			addResult(sectionResult{grid: grid, meta: sectionMeta{IsResume: true}, order: 0})
 		}
 	}()
 
@@ -121,7 +191,7 @@ func (hs *HomeScreen) loadData() {
 			return
 		}
 		if len(items) > 0 {
-			grid := NewPosterGrid("Next Up")
+			grid := NewPosterGrid(T("home.next_up"))
 			hs.convertItemsForGrid(grid, items)
 			addResult(sectionResult{grid: grid, meta: sectionMeta{}, order: 1})
 		}
@@ -137,34 +207,39 @@ func (hs *HomeScreen) loadData() {
 		for _, view := range views {
 			libViews = append(libViews, struct{ ID, Name string }{view.ID, view.Name})
 		}
+		serverExcluded := make(map[string]bool)
+		if ids, err := hs.client.LatestItemsExcludes(); err != nil {
+			log.Printf("Failed to load latest excludes: %v", err)
+		} else {
+			for _, id := range ids {
+				serverExcluded[id] = true
+			}
+		}
+
 		hs.mu.Lock()
 		hs.libraryViews = libViews
+		hs.serverExcluded = serverExcluded
+		hs.latestExcluded = make(map[string]bool)
+		for _, view := range libViews {
+			if hs.latestHidden(view.ID) {
+				hs.latestExcluded[view.ID] = true
+			}
+		}
+		excluded := hs.latestExcluded
 		hs.mu.Unlock()
 
+		// Latest items are fetched by Draw as each section nears the screen
 		for i, view := range views {
-			wg.Add(1)
-			go func(view jellyfin.MediaItem, order int) {
-				defer wg.Done()
-				items, err := hs.client.GetLatestMedia(view.ID, 20)
-				if err != nil {
-					log.Printf("Failed to load latest for %s: %v", view.Name, err)
-					return
-				}
-				if len(items) == 0 {
-					return
-				}
-				grid := NewPosterGrid("Latest " + view.Name)
-				hs.convertItemsForGrid(grid, items)
-				grid.Items = append(grid.Items, GridItem{
-					ID:    "_seeall_" + view.ID,
-					Title: "See All >",
-				})
-				addResult(sectionResult{
-					grid:  grid,
-					meta:  sectionMeta{IsLibrary: true, ParentID: view.ID, Title: view.Name},
-					order: order,
-				})
-			}(view, i+2) // order starts at 2 after Continue Watching and Next Up
+			if excluded[view.ID] {
+				continue
+			}
+			grid := NewPosterGrid(Tf("home.latest", view.Name))
+			grid.AccentLabel = true
+			addResult(sectionResult{
+				grid:  grid,
+				meta:  sectionMeta{IsLibrary: true, ParentID: view.ID, Title: view.Name, Pending: true},
+				order: i + 2, // after Continue Watching and Next Up
+			})
 		}
 	}
 
@@ -183,11 +258,7 @@ func (hs *HomeScreen) loadData() {
 	}
 
 	hs.mu.Lock()
-	hs.sections = sections
-	hs.sectionMeta = metas
-	if len(sections) > 0 {
-		sections[0].Active = true
-	}
+	hs.setSections(sections, metas)
 	if len(sections) == 0 && anyError != nil {
 		errMsg := anyError.Error()
 		hs.loadError = "Failed to load: " + errMsg
@@ -200,6 +271,22 @@ func (hs *HomeScreen) loadData() {
 	hs.mu.Unlock()
 }
 
+// setSections replaces the rows with a reload's. It can bring back fewer
+// sections than before, so focus stays on the same row where it still
+// exists; a menu opened on the old rows is stale. Caller must hold hs.mu.
+func (hs *HomeScreen) setSections(sections []*PosterGrid, metas []sectionMeta) {
+	hs.sections = sections
+	hs.sectionMeta = metas
+	hs.contextMenu = nil
+	hs.sectionIndex = max(0, min(hs.sectionIndex, len(sections)-1))
+	if len(sections) > 0 {
+		sections[hs.sectionIndex].Active = true
+		hs.ensureSectionVisible()
+	} else {
+		hs.ScrollY, hs.TargetScrollY = 0, 0
+	}
+}
+
 func (hs *HomeScreen) convertItemsForGrid(grid *PosterGrid, items []jellyfin.MediaItem) {
 	result := make([]GridItem, len(items))
 	for i, item := range items {
@@ -218,6 +305,19 @@ func (hs *HomeScreen) Update() (*ScreenTransition, error) {
 		return nil, nil
 	}
 
+	// Context menu captures all input while open
+	if hs.contextMenu != nil {
+		hs.contextMenu.Update()
+		if done, action, ok := hs.contextMenu.Done(); done {
+			item, target := hs.contextMenu.Item(), hs.contextMenu.Target()
+			hs.contextMenu = nil
+			if ok {
+				hs.runContextAction(item.ID, action, target)
+			}
+		}
+		return nil, nil
+	}
+
 	hs.ScrollState.HandleMouseWheel()
 
 	// Mouse click handling
@@ -225,6 +325,10 @@ func (hs *HomeScreen) Update() (*ScreenTransition, error) {
 	if clicked && hs.errDisplay.HandleClick(mx, my, hs.loadError) {
 		return nil, nil
 	}
+	if clicked && hs.loaded && len(hs.sections) == 0 && hs.loadError == "" && hs.emptyState.HandleClick(mx, my) {
+		hs.reload()
+		return nil, nil
+	}
 	if clicked && hs.loaded && len(hs.sections) > 0 {
 		for i, section := range hs.sections {
 			if idx, ok := section.HandleClick(mx, my); ok {
@@ -254,26 +358,56 @@ func (hs *HomeScreen) Update() (*ScreenTransition, error) {
 		}
 	}
 
-	// Right-click: toggle watched state
+	// Right-click: open context menu for the item under the cursor
 	rmx, rmy, rclicked := MouseJustRightClicked()
-	if rclicked && hs.loaded && len(hs.sections) > 0 {
-		for _, section := range hs.sections {
+	if rclicked && PosterRightClick != RightClickNone && hs.loaded && len(hs.sections) > 0 {
+		for i, section := range hs.sections {
 			if idx, ok := section.HandleClick(rmx, rmy); ok {
-				item := &section.Items[idx]
-				item.Watched = ToggleWatched(hs.client, item.ID, item.Watched)
+				hs.sections[hs.sectionIndex].Active = false
+				hs.sectionIndex = i
+				section.Active = true
+				section.Focused = idx
+				if PosterRightClick == RightClickMenu {
+					hs.openContextMenu(float64(rmx), float64(rmy))
+				} else if id := section.Items[idx].ID; !strings.HasPrefix(id, "_seeall_") {
+					hs.contextSection = i
+					hs.runContextAction(id, ActionToggleWatched, "")
+				}
 				return nil, nil
 			}
 		}
 	}
 
-	if !hs.loaded || len(hs.sections) == 0 {
+	if !hs.loaded {
 		return nil, nil
 	}
 
-	dir, enter, _ := InputState()
+	dir, enter, back := InputState()
+	if back {
+		return &ScreenTransition{Type: TransitionPop}, nil
+	}
+
+	// Nothing to show: OK retries, whether the load failed or came back empty
+	if len(hs.sections) == 0 {
+		if KeyJustPressed(ebiten.KeyArrowUp) {
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		}
+		if enter {
+			hs.reload()
+		}
+		return nil, nil
+	}
 
 	currentSection := hs.sections[hs.sectionIndex]
 
+	if target, ok := sectionJump(hs.sectionIndex, len(hs.sections)); ok {
+		currentSection.Active = false
+		hs.sectionIndex = target
+		hs.sections[target].Active = true
+		hs.ensureSectionVisible()
+		return nil, nil
+	}
+
 	switch dir {
 	case DirUp:
 		if hs.sectionIndex > 0 {
@@ -296,6 +430,21 @@ func (hs *HomeScreen) Update() (*ScreenTransition, error) {
 		currentSection.Update(dir)
 	}
 
+	if ContextMenuKeyPressed() {
+		if item := currentSection.SelectedItem(); item != nil {
+			hs.openContextMenu(item.X+PosterWidth/2, item.Y+PosterHeight/3)
+		}
+		return nil, nil
+	}
+
+	// Delete/X on a Continue Watching item removes it from the row
+	if inpututil.IsKeyJustPressed(ebiten.KeyDelete) || inpututil.IsKeyJustPressed(ebiten.KeyX) {
+		if hs.sectionIndex < len(hs.sectionMeta) && hs.sectionMeta[hs.sectionIndex].IsResume {
+			hs.removeFromResume(hs.sectionIndex, currentSection.Focused)
+			return nil, nil
+		}
+	}
+
 	if enter {
 		item := currentSection.SelectedItem()
 		if item != nil {
@@ -318,14 +467,210 @@ func (hs *HomeScreen) Update() (*ScreenTransition, error) {
 	return nil, nil
 }
 
+// openContextMenu opens the context menu for the focused item of the active section.
+// Caller must hold hs.mu.
+func (hs *HomeScreen) openContextMenu(x, y float64) {
+	item := hs.sections[hs.sectionIndex].SelectedItem()
+	if item == nil {
+		return
+	}
+	opts := ContextMenuOptions{
+		CanRequest: hs.OnRequest4K != nil,
+		CanRefresh: cachedIsAdmin(hs.client),
+		Playlists:  cachedPlaylists(hs.client),
+	}
+	if hs.sectionIndex < len(hs.sectionMeta) {
+		opts.InResume = hs.sectionMeta[hs.sectionIndex].IsResume
+	}
+	hs.contextMenu = NewContextMenu(*item, x, y, opts)
+	hs.contextSection = hs.sectionIndex
+}
+
+// runContextAction applies a context menu action to the item with itemID in the
+// section the menu was opened from. Caller must hold hs.mu.
+func (hs *HomeScreen) runContextAction(itemID string, action ContextAction, target string) {
+	if hs.contextSection >= len(hs.sections) {
+		return
+	}
+	section := hs.sections[hs.contextSection]
+	idx := -1
+	for i := range section.Items {
+		if section.Items[i].ID == itemID {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return
+	}
+	if action == ActionRemoveFromResume {
+		hs.removeFromResume(hs.contextSection, idx)
+		return
+	}
+	runContextAction(hs.client, &section.Items[idx], action, target, gridActionCallbacks{
+		OnItemSelected: hs.OnItemSelected,
+		OnPlay:         hs.OnPlay,
+		OnRequest4K:    hs.OnRequest4K,
+	})
+}
+
+// loadLibrarySection fetches the latest items of grid, the library section
+// for viewID, dropping the section if that fails or the library is empty.
+// Nothing changes if a reload replaced grid in the meantime.
+func (hs *HomeScreen) loadLibrarySection(grid *PosterGrid, viewID string) {
+	media, err := hs.client.GetLatestMedia(viewID, 20)
+	if err != nil {
+		log.Printf("Failed to load latest for %s: %v", viewID, err)
+	}
+
+	// Images are loaded into the new slice before it is shared; callbacks
+	// that run later lock hs.mu
+	var items []GridItem
+	if len(media) > 0 {
+		items = make([]GridItem, len(media), len(media)+1)
+		for i, item := range media {
+			items[i] = GridItemFromMediaItem(item)
+		}
+		items = append(items, GridItem{
+			ID:    "_seeall_" + viewID,
+			Title: "See All >",
+		})
+		LoadGridItemImages(hs.client, hs.imgCache, &items, media, &hs.mu)
+	}
+
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	for i, section := range hs.sections {
+		if section != grid {
+			continue
+		}
+		if len(items) == 0 {
+			hs.removeSection(i)
+		} else {
+			hs.sections[i].Items = items
+		}
+		return
+	}
+}
+
+// removeFromResume optimistically drops the item at idx from a Continue Watching
+// section and resets its playback position on the server in the background.
+// The section itself is removed once it is empty. Caller must hold hs.mu.
+func (hs *HomeScreen) removeFromResume(sectionIdx, idx int) {
+	section := hs.sections[sectionIdx]
+	if idx < 0 || idx >= len(section.Items) {
+		return
+	}
+	itemID := section.Items[idx].ID
+	go func() {
+		if err := hs.client.RemoveFromResume(itemID); err != nil {
+			log.Printf("Failed to remove %s from Continue Watching: %v", itemID, err)
+		}
+	}()
+
+	section.Items = append(section.Items[:idx], section.Items[idx+1:]...)
+	if section.Focused >= len(section.Items) {
+		section.Focused = len(section.Items) - 1
+	}
+	if len(section.Items) > 0 {
+		section.ensureVisible()
+		return
+	}
+
+	hs.removeSection(sectionIdx)
+}
+
+// removeSection drops the section at idx. If it had focus the next one takes
+// it; if it was above the focused one, the view shifts up with the rows.
+// Caller must hold hs.mu.
+func (hs *HomeScreen) removeSection(idx int) {
+	hs.sections = append(hs.sections[:idx], hs.sections[idx+1:]...)
+	hs.sectionMeta = append(hs.sectionMeta[:idx], hs.sectionMeta[idx+1:]...)
+	if idx < hs.sectionIndex {
+		hs.sectionIndex--
+		hs.ScrollY = max(0, hs.ScrollY-SectionFullHeight)
+		hs.TargetScrollY = max(0, hs.TargetScrollY-SectionFullHeight)
+		return
+	}
+	if idx > hs.sectionIndex {
+		return
+	}
+
+	if hs.sectionIndex >= len(hs.sections) {
+		hs.sectionIndex = len(hs.sections) - 1
+	}
+	if hs.sectionIndex >= 0 {
+		hs.sections[hs.sectionIndex].Active = true
+		hs.ensureSectionVisible()
+	} else {
+		hs.sectionIndex = 0
+	}
+}
+
 func (hs *HomeScreen) ensureSectionVisible() {
-	sectionHeight := float64(SectionFullHeight)
-	targetY := float64(hs.sectionIndex) * sectionHeight
-	maxScroll := targetY - float64(ScreenHeight)/4
-	if maxScroll < 0 {
-		maxScroll = 0
+	top := float64(NavBarHeight + 10) // where Draw starts the sections
+	hs.CenterRow(top+float64(hs.sectionIndex)*SectionFullHeight, SectionFullHeight, NavBarHeight)
+}
+
+// updateAmbient requests the focused item's backdrop once focus has rested on
+// it for ambientDelay. Caller must hold hs.mu.
+func (hs *HomeScreen) updateAmbient() {
+	url := ""
+	if hs.sectionIndex < len(hs.sections) {
+		if item := hs.sections[hs.sectionIndex].SelectedItem(); item != nil && !strings.HasPrefix(item.ID, "_seeall_") {
+			id := item.ID
+			if item.Type == "Episode" && item.SeriesID != "" {
+				id = item.SeriesID // episodes rarely have their own backdrop
+			}
+			url = hs.client.GetBackdropURL(id)
+		}
+	}
+	if url != hs.ambientWant {
+		hs.ambientWant = url
+		hs.ambientSince = time.Now()
+	}
+	if url == "" || url == hs.ambientReq || time.Since(hs.ambientSince) < ambientDelay {
+		return
 	}
-	hs.TargetScrollY = maxScroll
+	hs.ambientReq = url
+	// LoadAsync may call back at once, so not under hs.mu
+	go hs.imgCache.LoadAsync(url, func(img *ebiten.Image) {
+		hs.mu.Lock()
+		defer hs.mu.Unlock()
+		if url != hs.ambientWant || img == hs.ambient {
+			return
+		}
+		hs.ambientPrev = hs.ambient
+		hs.ambient = img
+		hs.ambientFade = 0
+	})
+}
+
+// drawAmbient fills the screen with the ambient backdrop, crossfading from
+// the previous one, under a dark overlay. Caller must hold hs.mu.
+func (hs *HomeScreen) drawAmbient(dst *ebiten.Image) {
+	if hs.ambient == nil {
+		return
+	}
+	hs.ambientFade = Lerp(hs.ambientFade, 1, FocusAnimSpeed/2)
+	if hs.ambientPrev != nil && hs.ambientFade < 0.99 {
+		drawBackdropFill(dst, hs.ambientPrev, 1-hs.ambientFade)
+	}
+	drawBackdropFill(dst, hs.ambient, hs.ambientFade)
+	vector.DrawFilledRect(dst, 0, 0, ScreenWidth, ScreenHeight,
+		color.RGBA{R: ColorBackground.R, G: ColorBackground.G, B: ColorBackground.B, A: 0xD0}, false)
+}
+
+// drawBackdropFill draws img scaled to cover the screen at the given opacity.
+func drawBackdropFill(dst, img *ebiten.Image, alpha float64) {
+	b := img.Bounds()
+	scale := max(float64(ScreenWidth)/float64(b.Dx()), float64(ScreenHeight)/float64(b.Dy()))
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate((float64(ScreenWidth)-float64(b.Dx())*scale)/2, (float64(ScreenHeight)-float64(b.Dy())*scale)/2)
+	op.ColorScale.ScaleAlpha(float32(alpha))
+	op.Filter = ebiten.FilterLinear
+	dst.DrawImage(img, op)
 }
 
 func (hs *HomeScreen) Draw(dst *ebiten.Image) {
@@ -335,7 +680,7 @@ func (hs *HomeScreen) Draw(dst *ebiten.Image) {
 	hs.ScrollState.Animate()
 
 	if !hs.loaded {
-		DrawTextCentered(dst, "Loading...", float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+		DrawTextCentered(dst, T("common.loading"), float64(ScreenWidth)/2, float64(ScreenHeight)/2,
 			FontSizeHeading, ColorTextSecondary)
 		return
 	}
@@ -344,21 +689,41 @@ func (hs *HomeScreen) Draw(dst *ebiten.Image) {
 		errX := float64(ScreenWidth)/2 - 300
 		errY := float64(ScreenHeight)/2 - 20
 		hs.errDisplay.Draw(dst, hs.loadError, errX, errY, FontSizeBody)
-		DrawTextCentered(dst, "Press Enter to retry", float64(ScreenWidth)/2, float64(ScreenHeight)/2+20,
+		DrawTextCentered(dst, T("common.press_enter_retry"), float64(ScreenWidth)/2, float64(ScreenHeight)/2+20,
 			FontSizeSmall, ColorTextMuted)
 		return
 	}
 
 	if len(hs.sections) == 0 {
-		DrawTextCentered(dst, "No media found", float64(ScreenWidth)/2, float64(ScreenHeight)/2,
-			FontSizeHeading, ColorTextSecondary)
+		hs.emptyState.Draw(dst, T("home.no_media"), T("home.check_connection"), true,
+			float64(ScreenWidth)/2, float64(ScreenHeight)/2)
 		return
 	}
 
+	if AmbientBackdrop {
+		hs.updateAmbient()
+		hs.drawAmbient(dst)
+	}
+
 	// Sections start below the navbar
 	y := float64(NavBarHeight+10) - hs.ScrollY
-	for _, section := range hs.sections {
+	for i, section := range hs.sections {
+		drawSectionScrim(dst, y)
 		h := section.Draw(dst, SectionPadding, y)
+		if meta := &hs.sectionMeta[i]; meta.IsLibrary && len(section.Items) == 0 {
+			DrawText(dst, T("common.loading"), SectionPadding, y+SectionTitleH+PosterHeight/2,
+				FontSizeBody, ColorTextMuted)
+			// Fetch a section's items once it is within a section of the screen
+			if meta.Pending && y < float64(ScreenHeight)+SectionFullHeight {
+				meta.Pending = false
+				go hs.loadLibrarySection(section, meta.ParentID)
+			}
+		}
 		y += h + SectionGap
 	}
+	hs.SetContentHeight(y+hs.ScrollY, float64(ScreenHeight))
+
+	if hs.contextMenu != nil {
+		hs.contextMenu.Draw(dst)
+	}
 }
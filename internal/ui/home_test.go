@@ -0,0 +1,54 @@
+package ui
+
+import "testing"
+
+func homeSections(n int) ([]*PosterGrid, []sectionMeta) {
+	sections := make([]*PosterGrid, n)
+	for i := range sections {
+		sections[i] = NewPosterGrid("")
+	}
+	return sections, make([]sectionMeta, n)
+}
+
+func TestHomeReloadWithFewerSections(t *testing.T) {
+	tests := []struct {
+		name      string
+		focused   int // section focused before the reload
+		reloaded  int // sections the reload brings back
+		wantIndex int
+	}{
+		{"focus past the new end", 4, 2, 1},
+		{"focus still in range", 1, 3, 1},
+		{"no sections left", 3, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs := &HomeScreen{}
+			hs.setSections(homeSections(5))
+			hs.sections[hs.sectionIndex].Active = false
+			hs.sectionIndex = tt.focused
+			hs.sections[hs.sectionIndex].Active = true
+			hs.ensureSectionVisible()
+			hs.contextMenu = &ContextMenu{}
+			hs.ScrollY = hs.TargetScrollY
+
+			sections, metas := homeSections(tt.reloaded)
+			hs.setSections(sections, metas)
+
+			if hs.sectionIndex != tt.wantIndex {
+				t.Errorf("sectionIndex = %d, want %d", hs.sectionIndex, tt.wantIndex)
+			}
+			for i, s := range hs.sections {
+				if s.Active != (i == hs.sectionIndex) {
+					t.Errorf("sections[%d].Active = %v with section %d focused", i, s.Active, hs.sectionIndex)
+				}
+			}
+			if hs.contextMenu != nil {
+				t.Error("context menu for the old sections kept open")
+			}
+			if tt.reloaded == 0 && (hs.ScrollY != 0 || hs.TargetScrollY != 0) {
+				t.Errorf("scroll = %v -> %v with no sections, want 0", hs.ScrollY, hs.TargetScrollY)
+			}
+		})
+	}
+}
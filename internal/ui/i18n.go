@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync/atomic"
+)
+
+// Translation tables, one JSON object of key → text per language.
+//
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// UILanguage is a bundled translation of the interface.
+type UILanguage struct {
+	Code string // config value and locales/<code>.json
+	Name string // in the language itself, for the settings picker
+}
+
+// UILanguages lists the bundled translations in settings order.
+var UILanguages = []UILanguage{
+	{"en", "English"},
+	{"nl", "Nederlands"},
+	{"de", "Deutsch"},
+	{"fr", "Français"},
+}
+
+var (
+	englishStrings = loadLocale("en")
+	activeLanguage atomic.Pointer[UILanguage]
+	activeStrings  atomic.Pointer[map[string]string]
+)
+
+func init() {
+	SetLanguage("en")
+}
+
+func loadLocale(code string) map[string]string {
+	data, err := localeFS.ReadFile("locales/" + code + ".json")
+	if err != nil {
+		return nil
+	}
+	var strs map[string]string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		log.Printf("i18n: bad locale %s: %v", code, err)
+		return nil
+	}
+	return strs
+}
+
+// SetLanguage switches the interface language. Unknown codes fall back to
+// English.
+func SetLanguage(code string) {
+	lang := UILanguages[0]
+	for _, l := range UILanguages {
+		if l.Code == code {
+			lang = l
+		}
+	}
+	if lang.Code != code && code != "" {
+		log.Printf("i18n: no translation for %q, using English", code)
+	}
+	strs := englishStrings
+	if lang.Code != "en" {
+		strs = loadLocale(lang.Code)
+	}
+	activeLanguage.Store(&lang)
+	activeStrings.Store(&strs)
+	applyLocale()
+}
+
+// Language returns the active interface language.
+func Language() UILanguage {
+	return *activeLanguage.Load()
+}
+
+// T returns the text for key in the active language, falling back to
+// English, then to the key itself.
+func T(key string) string {
+	if s, ok := (*activeStrings.Load())[key]; ok {
+		return s
+	}
+	if s, ok := englishStrings[key]; ok {
+		return s
+	}
+	return key
+}
+
+// Tf formats the text for key (see T) with args.
+func Tf(key string, args ...any) string {
+	return fmt.Sprintf(T(key), args...)
+}
@@ -161,3 +161,22 @@ func drawNavButton(dst *ebiten.Image, label string, x, y, w, h float32, focused
 		}
 	}
 }
+
+// drawPlayIcon draws a play triangle at (cx, cy) with given radius.
+func drawPlayIcon(dst *ebiten.Image, cx, cy, r float32, clr color.Color) {
+	var p vector.Path
+	p.MoveTo(cx-r*0.6, cy-r)
+	p.LineTo(cx+r, cy)
+	p.LineTo(cx-r*0.6, cy+r)
+	p.Close()
+	op := &vector.DrawPathOptions{AntiAlias: true}
+	op.ColorScale.ScaleWithColor(clr)
+	vector.FillPath(dst, &p, nil, op)
+}
+
+// drawPauseIcon draws two pause bars at (cx, cy) with given radius.
+func drawPauseIcon(dst *ebiten.Image, cx, cy, r float32, clr color.Color) {
+	barW := r * 0.6
+	vector.DrawFilledRect(dst, cx-r*0.8, cy-r, barW, r*2, clr, false)
+	vector.DrawFilledRect(dst, cx+r*0.8-barW, cy-r, barW, r*2, clr, false)
+}
@@ -20,6 +20,8 @@ type JellyseerrDiscoverScreen struct {
 
 	sections     []*PosterGrid
 	results      [][]jellyseerr.SearchResult // parallel to sections
+	paging       []*discoverPaging           // parallel to sections
+	generation   int                         // bumped when loadData replaces the sections
 	sectionIndex int
 	focusMode    int // 0=nav buttons, 1=sections
 	navBtnIndex  int // 0=My Requests, 1=Search (when focusMode==0)
@@ -28,14 +30,33 @@ type JellyseerrDiscoverScreen struct {
 	loadError    string
 	ScrollState
 
-	OnItemSelected func(result jellyseerr.SearchResult)
-	OnRequests     func()
-	OnSearch       func()
+	// HideAvailable hides results already in the library. Toggled with H.
+	HideAvailable bool
+
+	OnItemSelected         func(result jellyseerr.SearchResult)
+	OnRequests             func()
+	OnSearch               func()
+	OnHideAvailableChanged func(hide bool)
 
 	errDisplay ErrorDisplay
 	mu         sync.Mutex
 }
 
+// discoverLoadMoreAhead is how close to the end of a row focus gets before
+// the next page is fetched.
+const discoverLoadMoreAhead = 5
+
+// discoverPaging tracks the pages loaded for one discover section.
+type discoverPaging struct {
+	fetch      func(page int) (*jellyseerr.SearchResponse, error)
+	page       int
+	totalPages int
+	loading    bool // a page request is in flight
+	available  bool // lists only available media, so HideAvailable leaves it alone
+	// Every fetched result, before the availability filter
+	raw []jellyseerr.SearchResult
+}
+
 func NewJellyseerrDiscoverScreen(client *jellyseerr.Client, imgCache *cache.ImageCache) *JellyseerrDiscoverScreen {
 	return &JellyseerrDiscoverScreen{
 		client:    client,
@@ -56,43 +77,34 @@ func (ds *JellyseerrDiscoverScreen) OnEnter() {
 func (ds *JellyseerrDiscoverScreen) OnExit() {}
 
 func (ds *JellyseerrDiscoverScreen) loadData() {
-	type sectionData struct {
-		label   string
-		results []jellyseerr.SearchResult
-	}
-
 	type fetchResult struct {
-		index int
-		data  sectionData
-		err   error
+		index   int
+		results []jellyseerr.SearchResult
+		pages   int
+		err     error
 	}
 
 	fetchers := []struct {
-		label string
-		fetch func() (*jellyseerr.SearchResponse, error)
+		label     string
+		fetch     func(page int) (*jellyseerr.SearchResponse, error)
+		available bool
 	}{
-		{"Trending", func() (*jellyseerr.SearchResponse, error) { return ds.client.GetTrending(1) }},
-		{"Popular Movies", func() (*jellyseerr.SearchResponse, error) { return ds.client.GetDiscoverMovies(1) }},
-		{"Popular TV Shows", func() (*jellyseerr.SearchResponse, error) { return ds.client.GetDiscoverTV(1) }},
+		{"Trending", ds.client.GetTrending, false},
+		{"Recently Available", ds.client.GetRecentlyAvailable, true},
+		{"Popular Movies", ds.client.GetDiscoverMovies, false},
+		{"Popular TV Shows", ds.client.GetDiscoverTV, false},
 	}
 
 	ch := make(chan fetchResult, len(fetchers))
 	for i, f := range fetchers {
 		i, f := i, f
 		go func() {
-			resp, err := f.fetch()
+			resp, err := f.fetch(1)
 			if err != nil {
 				ch <- fetchResult{index: i, err: err}
 				return
 			}
-			// Filter out "person" results
-			var filtered []jellyseerr.SearchResult
-			for _, r := range resp.Results {
-				if r.MediaType == "movie" || r.MediaType == "tv" {
-					filtered = append(filtered, r)
-				}
-			}
-			ch <- fetchResult{index: i, data: sectionData{label: f.label, results: filtered}}
+			ch <- fetchResult{index: i, results: filterMediaResults(resp.Results), pages: resp.TotalPages}
 		}()
 	}
 
@@ -113,56 +125,174 @@ func (ds *JellyseerrDiscoverScreen) loadData() {
 
 	var sections []*PosterGrid
 	var allResults [][]jellyseerr.SearchResult
+	var paging []*discoverPaging
 
 	for _, r := range ordered {
 		if r.err != nil {
 			log.Printf("Discover: failed to load %s: %v", fetchers[r.index].label, r.err)
 			continue
 		}
-		if len(r.data.results) == 0 {
+		if len(r.results) == 0 {
 			continue
 		}
 
-		grid := NewPosterGrid(r.data.label)
-		items := make([]GridItem, len(r.data.results))
-		for i, result := range r.data.results {
-			items[i] = GridItem{
-				ID:            fmt.Sprintf("%d", result.ID),
-				Title:         result.DisplayTitle(),
-				Subtitle:      result.Year(),
-				Rating:        result.VoteAverage,
-				RequestStatus: ds.mediaStatus(result),
-			}
-			posterURL := result.PosterURL()
-			if posterURL == "" {
-				continue
-			}
-			if img := ds.imgCache.Get(posterURL); img != nil {
-				items[i].Image = img
-			} else {
-				idx := i
-				ds.imgCache.LoadAsync(posterURL, func(img *ebiten.Image) {
-					ds.mu.Lock()
-					defer ds.mu.Unlock()
-					if idx < len(grid.Items) {
-						grid.Items[idx].Image = img
-					}
-				})
-			}
+		pg := &discoverPaging{
+			fetch:      fetchers[r.index].fetch,
+			page:       1,
+			totalPages: r.pages,
+			available:  fetchers[r.index].available,
+			raw:        r.results,
 		}
-		grid.Items = items
+		grid := NewPosterGrid(fetchers[r.index].label)
+		visible := ds.filterAvailable(pg, r.results)
+		ds.appendResults(grid, visible)
 		sections = append(sections, grid)
-		allResults = append(allResults, r.data.results)
+		allResults = append(allResults, visible)
+		paging = append(paging, pg)
 	}
 
 	ds.sections = sections
 	ds.results = allResults
+	ds.paging = paging
+	ds.generation++
 	if len(sections) > 0 {
 		sections[0].Active = true
 	}
 	if len(sections) == 0 && anyError != nil {
 		ds.loadError = "Failed to load: " + anyError.Error()
 	}
+	for i := range sections {
+		ds.maybeLoadMore(i)
+	}
+}
+
+// filterAvailable drops results already in the library when HideAvailable is
+// set, except in sections of available media. Caller must hold ds.mu.
+func (ds *JellyseerrDiscoverScreen) filterAvailable(pg *discoverPaging, results []jellyseerr.SearchResult) []jellyseerr.SearchResult {
+	if !ds.HideAvailable || pg.available {
+		return results
+	}
+	var filtered []jellyseerr.SearchResult
+	for _, r := range results {
+		if ds.mediaStatus(r) < jellyseerr.StatusAvailable {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// toggleHideAvailable flips HideAvailable and rebuilds every section from the
+// results fetched so far. Caller must hold ds.mu.
+func (ds *JellyseerrDiscoverScreen) toggleHideAvailable() {
+	ds.HideAvailable = !ds.HideAvailable
+	if ds.OnHideAvailableChanged != nil {
+		ds.OnHideAvailableChanged(ds.HideAvailable)
+	}
+	for i, grid := range ds.sections {
+		visible := ds.filterAvailable(ds.paging[i], ds.paging[i].raw)
+		grid.Items = nil
+		grid.Focused = 0
+		grid.targetOffsetX = 0
+		ds.results[i] = visible
+		ds.appendResults(grid, visible)
+		ds.maybeLoadMore(i)
+	}
+}
+
+// filterMediaResults drops "person" results, keeping movies and TV shows.
+func filterMediaResults(results []jellyseerr.SearchResult) []jellyseerr.SearchResult {
+	var filtered []jellyseerr.SearchResult
+	for _, r := range results {
+		if r.MediaType == "movie" || r.MediaType == "tv" {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// appendResults adds grid items for results to grid and starts their poster loads.
+// Caller must hold ds.mu.
+func (ds *JellyseerrDiscoverScreen) appendResults(grid *PosterGrid, results []jellyseerr.SearchResult) {
+	for _, result := range results {
+		idx := len(grid.Items)
+		grid.Items = append(grid.Items, GridItem{
+			ID:            fmt.Sprintf("%d", result.ID),
+			Title:         result.DisplayTitle(),
+			Subtitle:      result.Year(),
+			Rating:        result.VoteAverage,
+			RequestStatus: ds.mediaStatus(result),
+		})
+		posterURL := result.PosterURL()
+		if posterURL == "" {
+			continue
+		}
+		if img := ds.imgCache.Get(posterURL); img != nil {
+			grid.Items[idx].Image = img
+		} else {
+			key := grid.Items[idx]
+			ds.imgCache.LoadAsync(posterURL, func(img *ebiten.Image) {
+				ds.mu.Lock()
+				defer ds.mu.Unlock()
+				// The row may have been rebuilt by the availability filter
+				setImageFor(grid.Items, key, img)
+			})
+		}
+	}
+}
+
+// maybeLoadMore fetches the next page of section si once focus nears its end.
+// Caller must hold ds.mu.
+func (ds *JellyseerrDiscoverScreen) maybeLoadMore(si int) {
+	if si >= len(ds.paging) {
+		return
+	}
+	pg := ds.paging[si]
+	if pg.loading || pg.page >= pg.totalPages {
+		return
+	}
+	if len(ds.sections[si].Items)-ds.sections[si].Focused > discoverLoadMoreAhead {
+		return
+	}
+	pg.loading = true
+	go ds.loadMore(ds.generation, si, pg, pg.page+1)
+}
+
+// loadMore fetches page of section si and appends results not already shown.
+// The page is dropped if the sections were reloaded in the meantime, since
+// si may then name another row or none.
+func (ds *JellyseerrDiscoverScreen) loadMore(generation, si int, pg *discoverPaging, page int) {
+	resp, err := pg.fetch(page)
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	pg.loading = false
+	if ds.generation != generation {
+		return
+	}
+	if err != nil {
+		log.Printf("Discover: failed to load page %d of %s: %v", page, ds.sections[si].Label, err)
+		return
+	}
+	pg.page = page
+	pg.totalPages = resp.TotalPages
+
+	// Trending lists shift between requests, so pages can overlap
+	seen := make(map[string]bool, len(pg.raw))
+	for _, r := range pg.raw {
+		seen[fmt.Sprintf("%s/%d", r.MediaType, r.ID)] = true
+	}
+	var fresh []jellyseerr.SearchResult
+	for _, r := range filterMediaResults(resp.Results) {
+		key := fmt.Sprintf("%s/%d", r.MediaType, r.ID)
+		if !seen[key] {
+			seen[key] = true
+			fresh = append(fresh, r)
+		}
+	}
+	pg.raw = append(pg.raw, fresh...)
+	fresh = ds.filterAvailable(pg, fresh)
+	ds.appendResults(ds.sections[si], fresh)
+	ds.results[si] = append(ds.results[si], fresh...)
 }
 
 func (ds *JellyseerrDiscoverScreen) mediaStatus(r jellyseerr.SearchResult) int {
@@ -216,7 +346,7 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 	if clicked {
 		// My Requests button
 		reqX := ds.reqBtnX()
-		if PointInRect(mx, my, reqX, discNavBtnY, discReqBtnW, discNavBtnH) {
+		if PointInHitRect(mx, my, reqX, discNavBtnY, discReqBtnW, discNavBtnH) {
 			if ds.OnRequests != nil {
 				ds.OnRequests()
 			}
@@ -224,7 +354,7 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 		}
 		// Search button
 		searchX := ds.searchBtnX()
-		if PointInRect(mx, my, searchX, discNavBtnY, discSrchBtnW, discNavBtnH) {
+		if PointInHitRect(mx, my, searchX, discNavBtnY, discSrchBtnW, discNavBtnH) {
 			if ds.OnSearch != nil {
 				ds.OnSearch()
 			}
@@ -252,7 +382,7 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 
 	// Nav buttons focused
 	if ds.focusMode == 0 {
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || enter {
+		if KeyJustPressed(ebiten.KeyEnter) || enter {
 			if ds.navBtnIndex == 0 {
 				if ds.OnRequests != nil {
 					ds.OnRequests()
@@ -265,22 +395,22 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 			return nil, nil
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		if KeyJustPressed(ebiten.KeyArrowRight) {
 			if ds.navBtnIndex == 0 {
 				ds.navBtnIndex = 1
 			}
 			return nil, nil
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		if KeyJustPressed(ebiten.KeyArrowLeft) {
 			if ds.navBtnIndex == 1 {
 				ds.navBtnIndex = 0
 			}
 			return nil, nil
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		if KeyJustPressed(ebiten.KeyArrowUp) {
 			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) && ds.loaded && len(ds.sections) > 0 {
+		if KeyJustPressed(ebiten.KeyArrowDown) && ds.loaded && len(ds.sections) > 0 {
 			ds.focusMode = 1
 			ds.sections[ds.sectionIndex].Active = true
 			return nil, nil
@@ -301,6 +431,10 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 		}
 		return nil, nil
 	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyH) && ds.loaded {
+		ds.toggleHideAvailable()
+		return nil, nil
+	}
 
 	if !ds.loaded || len(ds.sections) == 0 {
 		return nil, nil
@@ -309,6 +443,14 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 	dir, _, _ := InputState()
 	currentSection := ds.sections[ds.sectionIndex]
 
+	if target, ok := sectionJump(ds.sectionIndex, len(ds.sections)); ok {
+		currentSection.Active = false
+		ds.sectionIndex = target
+		ds.sections[target].Active = true
+		ds.ensureSectionVisible()
+		return nil, nil
+	}
+
 	switch dir {
 	case DirUp:
 		if ds.sectionIndex > 0 {
@@ -331,6 +473,7 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 		}
 	case DirLeft, DirRight:
 		currentSection.Update(dir)
+		ds.maybeLoadMore(ds.sectionIndex)
 	}
 
 	if enter {
@@ -347,13 +490,8 @@ func (ds *JellyseerrDiscoverScreen) Update() (*ScreenTransition, error) {
 }
 
 func (ds *JellyseerrDiscoverScreen) ensureSectionVisible() {
-	sectionHeight := float64(SectionFullHeight)
-	targetY := float64(ds.sectionIndex) * sectionHeight
-	maxScroll := targetY - float64(ScreenHeight)/4
-	if maxScroll < 0 {
-		maxScroll = 0
-	}
-	ds.TargetScrollY = maxScroll
+	top := float64(NavBarHeight*2 + 10) // where Draw starts the sections
+	ds.CenterRow(top+float64(ds.sectionIndex)*SectionFullHeight, SectionFullHeight, NavBarHeight*2)
 }
 
 func (ds *JellyseerrDiscoverScreen) Draw(dst *ebiten.Image) {
@@ -364,6 +502,12 @@ func (ds *JellyseerrDiscoverScreen) Draw(dst *ebiten.Image) {
 
 	// Header (below navbar)
 	DrawText(dst, "Discovery", SectionPadding, NavBarHeight+16, FontSizeTitle, ColorPrimary)
+	hint, hintClr := "H: hide available", ColorTextMuted
+	if ds.HideAvailable {
+		hint, hintClr = "Hiding available (H)", ColorAccent
+	}
+	tw, _ := MeasureText("Discovery", FontSizeTitle)
+	DrawText(dst, hint, SectionPadding+tw+24, NavBarHeight+26, FontSizeSmall, hintClr)
 
 	// My Requests button
 	reqX := float32(ds.reqBtnX())
@@ -380,7 +524,7 @@ func (ds *JellyseerrDiscoverScreen) Draw(dst *ebiten.Image) {
 		ColorPrimary)
 
 	if !ds.loaded {
-		DrawTextCentered(dst, "Loading...", float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+		DrawTextCentered(dst, T("common.loading"), float64(ScreenWidth)/2, float64(ScreenHeight)/2,
 			FontSizeHeading, ColorTextSecondary)
 		return
 	}
@@ -400,8 +544,9 @@ func (ds *JellyseerrDiscoverScreen) Draw(dst *ebiten.Image) {
 
 	y := float64(NavBarHeight*2+10) - ds.ScrollY
 	for _, section := range ds.sections {
+		drawSectionScrim(dst, y)
 		h := section.Draw(dst, SectionPadding, y)
 		y += h + SectionGap
 	}
-
+	ds.SetContentHeight(y+ds.ScrollY, float64(ScreenHeight))
 }
@@ -0,0 +1,222 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/depeter/jellycouch/internal/cache"
+	"github.com/depeter/jellycouch/internal/jellyseerr"
+)
+
+// personBioMaxLen caps the biography shown above the known-for grid.
+const personBioMaxLen = 420
+
+// JellyseerrPersonScreen shows a person and the titles they are known for.
+// Selecting a title goes on to the request flow.
+type JellyseerrPersonScreen struct {
+	client   *jellyseerr.Client
+	imgCache *cache.ImageCache
+
+	person    jellyseerr.SearchResult
+	detail    *jellyseerr.PersonDetail
+	gridItems []GridItem
+	grid      *FocusGrid
+	loaded    bool
+	loading   bool
+	loadError string
+	gridBaseY float64 // set during Draw, below the biography
+
+	ScrollState
+
+	OnItemSelected func(result jellyseerr.SearchResult)
+
+	errDisplay ErrorDisplay
+	mu         sync.Mutex
+}
+
+func NewJellyseerrPersonScreen(client *jellyseerr.Client, imgCache *cache.ImageCache, person jellyseerr.SearchResult) *JellyseerrPersonScreen {
+	cols := (ScreenWidth - SectionPadding*2) / (PosterWidth + PosterGap)
+	return &JellyseerrPersonScreen{
+		client:   client,
+		imgCache: imgCache,
+		person:   person,
+		grid:     NewFocusGrid(cols, 0),
+	}
+}
+
+func (ps *JellyseerrPersonScreen) Name() string { return ps.person.Name }
+
+func (ps *JellyseerrPersonScreen) OnEnter() {
+	if !ps.loaded && !ps.loading {
+		ps.loading = true
+		go ps.loadData()
+	}
+}
+
+func (ps *JellyseerrPersonScreen) OnExit() {}
+
+func (ps *JellyseerrPersonScreen) loadData() {
+	detail, err := ps.client.GetPerson(ps.person.ID)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.loading = false
+	if err != nil {
+		ps.loadError = "Failed to load: " + err.Error()
+		return
+	}
+
+	ps.detail = detail
+	ps.loaded = true
+	ps.loadError = ""
+	ps.grid.SetTotal(len(detail.KnownFor))
+	ps.gridItems = make([]GridItem, len(detail.KnownFor))
+	for i, result := range detail.KnownFor {
+		var status int
+		if result.MediaInfo != nil {
+			status = result.MediaInfo.Status
+		}
+		ps.gridItems[i] = GridItem{
+			ID:            fmt.Sprintf("%d", result.ID),
+			Title:         result.DisplayTitle(),
+			Subtitle:      result.Year(),
+			Rating:        result.VoteAverage,
+			RequestStatus: status,
+		}
+		posterURL := result.PosterURL()
+		if posterURL == "" {
+			continue
+		}
+		if img := ps.imgCache.Get(posterURL); img != nil {
+			ps.gridItems[i].Image = img
+		} else {
+			key := ps.gridItems[i]
+			ps.imgCache.LoadAsync(posterURL, func(img *ebiten.Image) {
+				ps.mu.Lock()
+				defer ps.mu.Unlock()
+				setImageFor(ps.gridItems, key, img)
+			})
+		}
+	}
+}
+
+func (ps *JellyseerrPersonScreen) Update() (*ScreenTransition, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	dir, enter, back := InputState()
+	if back {
+		return &ScreenTransition{Type: TransitionPop}, nil
+	}
+
+	ps.ScrollState.HandleMouseWheel()
+
+	mx, my, clicked := MouseJustClicked()
+	if clicked && ps.errDisplay.HandleClick(mx, my, ps.loadError) {
+		return nil, nil
+	}
+	if clicked && ps.loaded {
+		if idx, ok := ps.grid.HandleClick(mx, my, SectionPadding, ps.gridBaseY-ps.ScrollY); ok {
+			ps.grid.Focused = idx
+			ps.selectItem(idx)
+			return nil, nil
+		}
+	}
+
+	if !ps.loaded || len(ps.gridItems) == 0 {
+		if dir == DirUp {
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		}
+		return nil, nil
+	}
+
+	if dir != DirNone {
+		if dir == DirUp && ps.grid.FocusedRow() == 0 {
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		}
+		ps.grid.Update(dir)
+		ps.ensureVisible()
+	}
+
+	if enter {
+		ps.selectItem(ps.grid.Focused)
+	}
+	return nil, nil
+}
+
+// selectItem opens the request screen for a known-for title. Caller must hold ps.mu.
+func (ps *JellyseerrPersonScreen) selectItem(idx int) {
+	if ps.detail == nil || idx < 0 || idx >= len(ps.detail.KnownFor) || ps.OnItemSelected == nil {
+		return
+	}
+	ps.OnItemSelected(ps.detail.KnownFor[idx])
+}
+
+func (ps *JellyseerrPersonScreen) ensureVisible() {
+	rowH := ps.grid.RowHeight()
+	ps.CenterRow(ps.gridBaseY+float64(ps.grid.FocusedRow())*rowH, rowH, NavBarHeight)
+}
+
+func (ps *JellyseerrPersonScreen) Draw(dst *ebiten.Image) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.ScrollState.Animate()
+
+	y := float64(NavBarHeight+16) - ps.ScrollY
+	DrawText(dst, ps.person.Name, SectionPadding, y, FontSizeTitle, ColorPrimary)
+	y += FontSizeTitle + 12
+
+	if ps.loadError != "" && !ps.loaded {
+		ps.errDisplay.Draw(dst, ps.loadError, SectionPadding, y+40, FontSizeBody)
+		return
+	}
+	if !ps.loaded {
+		DrawTextCentered(dst, T("common.loading"), float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+			FontSizeHeading, ColorTextSecondary)
+		return
+	}
+
+	meta := ps.detail.KnownForDepartment
+	if len(ps.detail.Birthday) >= 4 {
+		if meta != "" {
+			meta += " \u2022 "
+		}
+		meta += "Born " + ps.detail.Birthday[:4]
+		if ps.detail.PlaceOfBirth != "" {
+			meta += ", " + ps.detail.PlaceOfBirth
+		}
+	}
+	if meta != "" {
+		DrawText(dst, meta, SectionPadding, y, FontSizeSmall, ColorTextMuted)
+		y += FontSizeSmall + 12
+	}
+
+	if bio := ps.detail.Biography; bio != "" {
+		if r := []rune(bio); len(r) > personBioMaxLen {
+			bio = string(r[:personBioMaxLen]) + "..."
+		}
+		maxW := float64(ScreenWidth - SectionPadding*2)
+		y += DrawTextWrapped(dst, bio, SectionPadding, y, maxW, FontSizeSmall, ColorTextSecondary) + 16
+	}
+
+	DrawText(dst, "Known For", SectionPadding, y, FontSizeHeading, ColorText)
+	y += FontSizeHeading + 16
+	ps.gridBaseY = y + ps.ScrollY
+
+	if len(ps.gridItems) == 0 {
+		DrawText(dst, "No movies or shows found", SectionPadding, y, FontSizeBody, ColorTextSecondary)
+		return
+	}
+
+	for i, item := range ps.gridItems {
+		x, iy := ps.grid.ItemRect(i, SectionPadding, y)
+		if iy+PosterHeight < 0 || iy > float64(ScreenHeight) {
+			continue
+		}
+		drawPosterItem(dst, item, x, iy, i == ps.grid.Focused)
+	}
+	ps.SetGridRows((len(ps.gridItems)+ps.grid.Cols-1)/ps.grid.Cols, ps.grid.RowHeight(), ps.gridBaseY, 24)
+}
@@ -3,12 +3,14 @@ package ui
 import (
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/depeter/jellycouch/internal/cache"
+	"github.com/depeter/jellycouch/internal/config"
 	"github.com/depeter/jellycouch/internal/jellyseerr"
 )
 
@@ -34,7 +36,18 @@ type JellyseerrRequestScreen struct {
 	selectedLang    int
 	is4K            bool
 	servicesLoaded  bool
-	optionIndex     int // focused option row
+	servicesWanted  bool // loadServiceSettings has been started
+	optionIndex     int  // focused option row
+
+	// Users an admin can request on behalf of, and the one chosen.
+	// Empty for everyone else, which hides the option.
+	users         []jellyseerr.RequestUser
+	selectedUser  int
+	currentUserID int
+
+	// "Request Missing" on partially available shows: first press asks for
+	// confirmation, the second submits every missing season at once.
+	confirmMissing bool
 
 	// Focus mode: 0=buttons, 1=request options, 2=season selection
 	focusMode   int
@@ -48,11 +61,22 @@ type JellyseerrRequestScreen struct {
 	reqSuccess string
 
 	wantBack    bool
-	trailerURL  string
 	voteAverage float64
 
+	// Trailers and teasers, best first. With more than one the Trailers
+	// button opens a list to pick from.
+	trailers       []jellyseerr.RelatedVideo
+	trailerPicking bool
+	trailerIndex   int
+	trailerRects   []ButtonRect
+
+	// Defaults holds the last-used request options; updated on each request
+	// and followed by OnDefaultsChanged so the caller can save it.
+	Defaults *config.JellyseerrConfig
+
 	// Callbacks
-	OnPlayTrailer func(url string)
+	OnPlayTrailer     func(url string)
+	OnDefaultsChanged func()
 
 	errDisplay ErrorDisplay
 	mu         sync.Mutex
@@ -73,6 +97,27 @@ func NewJellyseerrRequestScreen(client *jellyseerr.Client, imgCache *cache.Image
 	return jr
 }
 
+// RequestIn4K preselects the 4K toggle and tracks 4K availability instead of
+// the regular status, so titles already available in HD can still be requested.
+func (jr *JellyseerrRequestScreen) RequestIn4K() {
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	jr.is4K = true
+	jr.status = jr.mediaStatus(jr.result.MediaInfo)
+	jr.updateButtons()
+}
+
+// mediaStatus returns the status relevant to the current request (4K or regular).
+func (jr *JellyseerrRequestScreen) mediaStatus(info *jellyseerr.MediaInfo) int {
+	if info == nil {
+		return 0
+	}
+	if jr.is4K {
+		return info.Status4K
+	}
+	return info.Status
+}
+
 func (jr *JellyseerrRequestScreen) Name() string {
 	return "Request: " + jr.result.DisplayTitle()
 }
@@ -92,17 +137,21 @@ func (jr *JellyseerrRequestScreen) OnEnter() {
 		}
 	}
 
+	// Load service settings for request options
+	jr.mu.Lock()
+	if jr.status < jellyseerr.StatusPending {
+		jr.servicesWanted = true
+		go jr.loadServiceSettings()
+		go jr.loadUsers()
+	}
+	jr.mu.Unlock()
+
 	// Load detail for trailer info + season selection
 	if jr.result.MediaType == "tv" {
 		go jr.loadTVDetail()
 	} else if jr.result.MediaType == "movie" {
 		go jr.loadMovieDetail()
 	}
-
-	// Load service settings for request options
-	if jr.status < jellyseerr.StatusPending {
-		go jr.loadServiceSettings()
-	}
 }
 
 func (jr *JellyseerrRequestScreen) OnExit() {}
@@ -116,19 +165,90 @@ func (jr *JellyseerrRequestScreen) loadTVDetail() {
 	jr.mu.Lock()
 	jr.tvDetail = detail
 	jr.voteAverage = detail.VoteAverage
-	if detail.MediaInfo != nil && detail.MediaInfo.Status > jr.status {
-		jr.status = detail.MediaInfo.Status
+	if st := jr.mediaStatus(detail.MediaInfo); st > jr.status {
+		jr.status = st
 	}
-	jr.trailerURL = detail.TrailerURL()
+	jr.trailers = detail.Trailers()
 	// Initialize season selection (all selected by default, skip specials)
 	jr.selectedSeasons = make([]bool, len(detail.Seasons))
 	for i, s := range detail.Seasons {
 		jr.selectedSeasons[i] = s.SeasonNumber > 0
 	}
+	// Missing seasons of a partially available show are requested with the
+	// usual server options, so make sure those are loaded too
+	if len(jr.missingSeasons()) > 0 && !jr.servicesWanted {
+		jr.servicesWanted = true
+		go jr.loadServiceSettings()
+	}
 	jr.updateButtons()
 	jr.mu.Unlock()
 }
 
+// missingSeasons returns the regular seasons of a partially available show
+// that are neither available nor already requested. Without per-season info
+// every regular season counts.
+func (jr *JellyseerrRequestScreen) missingSeasons() []int {
+	if jr.tvDetail == nil {
+		return nil
+	}
+	info := jr.tvDetail.MediaInfo
+	if jr.mediaStatus(info) != jellyseerr.StatusPartiallyAvailable {
+		return nil
+	}
+	taken := make(map[int]bool)
+	if len(info.Seasons) == 0 {
+		return regularSeasons(jr.tvDetail.Seasons, taken)
+	}
+	for _, s := range info.Seasons {
+		st := s.Status
+		if jr.is4K {
+			st = s.Status4K
+		}
+		if st >= jellyseerr.StatusPending {
+			taken[s.SeasonNumber] = true
+		}
+	}
+	for _, req := range info.Requests {
+		if req.Is4K != jr.is4K || req.Status == jellyseerr.RequestDeclined {
+			continue
+		}
+		for _, s := range req.Seasons {
+			taken[s.SeasonNumber] = true
+		}
+	}
+	return regularSeasons(jr.tvDetail.Seasons, taken)
+}
+
+// regularSeasons returns the numbers of the seasons past the specials that
+// aren't taken.
+func regularSeasons(seasons []jellyseerr.Season, taken map[int]bool) []int {
+	var out []int
+	for _, s := range seasons {
+		if s.SeasonNumber > 0 && !taken[s.SeasonNumber] {
+			out = append(out, s.SeasonNumber)
+		}
+	}
+	return out
+}
+
+// trailerButtonLabel returns the label of the trailer button, with the count
+// when there is a choice.
+func (jr *JellyseerrRequestScreen) trailerButtonLabel() string {
+	if len(jr.trailers) > 1 {
+		return fmt.Sprintf("Trailers (%d)", len(jr.trailers))
+	}
+	return "Trailer"
+}
+
+// missingButtonLabel returns the label of the "Request Missing" button.
+func (jr *JellyseerrRequestScreen) missingButtonLabel() string {
+	n := len(jr.missingSeasons())
+	if jr.confirmMissing {
+		return fmt.Sprintf("Confirm %d Seasons", n)
+	}
+	return fmt.Sprintf("Request %d Missing", n)
+}
+
 func (jr *JellyseerrRequestScreen) loadMovieDetail() {
 	detail, err := jr.client.GetMovie(jr.result.ID)
 	if err != nil {
@@ -137,10 +257,10 @@ func (jr *JellyseerrRequestScreen) loadMovieDetail() {
 	}
 	jr.mu.Lock()
 	jr.voteAverage = detail.VoteAverage
-	if detail.MediaInfo != nil && detail.MediaInfo.Status > jr.status {
-		jr.status = detail.MediaInfo.Status
+	if st := jr.mediaStatus(detail.MediaInfo); st > jr.status {
+		jr.status = st
 	}
-	jr.trailerURL = detail.TrailerURL()
+	jr.trailers = detail.Trailers()
 	jr.updateButtons()
 	jr.mu.Unlock()
 }
@@ -171,28 +291,89 @@ func (jr *JellyseerrRequestScreen) loadServiceSettings() {
 	}
 }
 
-func (jr *JellyseerrRequestScreen) preselectRadarrDefaults() {
-	// Find default non-4K server
-	for i, s := range jr.radarrServers {
-		if s.IsDefault && !s.Is4K {
+// loadUsers fills the "Request As" choice when the API key's user may request
+// on behalf of others, with that user preselected.
+func (jr *JellyseerrRequestScreen) loadUsers() {
+	me, err := jr.client.GetCurrentUser()
+	if err != nil {
+		log.Printf("Jellyseerr current user: %v", err)
+		return
+	}
+	if !me.CanRequestOnBehalf() {
+		return
+	}
+	users, err := jr.client.GetUsers()
+	if err != nil {
+		log.Printf("Failed to load Jellyseerr users: %v", err)
+		return
+	}
+	if len(users) < 2 {
+		return
+	}
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	jr.users = users
+	jr.currentUserID = me.ID
+	for i, u := range users {
+		if u.ID == me.ID {
+			jr.selectedUser = i
+		}
+	}
+}
+
+// preselectServer picks the server last used for this service and 4K toggle,
+// falling back to the server marked as default in Jellyseerr.
+// server(i) describes the i-th of n servers.
+func (jr *JellyseerrRequestScreen) preselectServer(service string, n int, server func(i int) (id int, isDefault, is4K bool)) {
+	if jr.Defaults != nil {
+		if last, ok := jr.Defaults.LastRequestServer(service, jr.is4K); ok {
+			for i := 0; i < n; i++ {
+				if id, _, is4K := server(i); id == last && is4K == jr.is4K {
+					jr.selectedServer = i
+					return
+				}
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if _, isDefault, is4K := server(i); isDefault && is4K == jr.is4K {
 			jr.selectedServer = i
-			break
+			return
 		}
 	}
+}
+
+func (jr *JellyseerrRequestScreen) preselectRadarrDefaults() {
+	jr.preselectServer("radarr", len(jr.radarrServers), func(i int) (int, bool, bool) {
+		s := jr.radarrServers[i]
+		return s.ID, s.IsDefault, s.Is4K
+	})
+	jr.preselectRadarrOptions()
+}
+
+// preselectRadarrOptions selects the profile and root folder for the chosen
+// server: the ones last used with it, else the server's active ones.
+func (jr *JellyseerrRequestScreen) preselectRadarrOptions() {
 	if jr.selectedServer >= len(jr.radarrServers) {
 		return
 	}
 	srv := jr.radarrServers[jr.selectedServer]
-	// Pre-select active profile
+	profileID, folder := srv.ActiveProfileID, srv.ActiveDirectory
+	if jr.Defaults != nil {
+		if d, ok := jr.Defaults.RequestDefaultsFor("radarr", srv.ID); ok {
+			profileID, folder = d.ProfileID, d.RootFolder
+		}
+	}
+	// Pre-select profile
 	for i, p := range srv.Profiles {
-		if p.ID == srv.ActiveProfileID {
+		if p.ID == profileID {
 			jr.selectedProfile = i
 			break
 		}
 	}
-	// Pre-select active root folder
+	// Pre-select root folder
 	for i, f := range srv.RootFolders {
-		if f.Path == srv.ActiveDirectory {
+		if f.Path == folder {
 			jr.selectedFolder = i
 			break
 		}
@@ -200,44 +381,74 @@ func (jr *JellyseerrRequestScreen) preselectRadarrDefaults() {
 }
 
 func (jr *JellyseerrRequestScreen) preselectSonarrDefaults() {
-	// Find default non-4K server
-	for i, s := range jr.sonarrServers {
-		if s.IsDefault && !s.Is4K {
-			jr.selectedServer = i
-			break
-		}
-	}
+	jr.preselectServer("sonarr", len(jr.sonarrServers), func(i int) (int, bool, bool) {
+		s := jr.sonarrServers[i]
+		return s.ID, s.IsDefault, s.Is4K
+	})
+	jr.preselectSonarrOptions()
+}
+
+// preselectSonarrOptions selects the profile, root folder and language profile
+// for the chosen server: the ones last used with it, else the server's active ones.
+func (jr *JellyseerrRequestScreen) preselectSonarrOptions() {
 	if jr.selectedServer >= len(jr.sonarrServers) {
 		return
 	}
 	srv := jr.sonarrServers[jr.selectedServer]
+	profileID, folder, langID := srv.ActiveProfileID, srv.ActiveDirectory, srv.ActiveLanguageProfileID
+	if jr.Defaults != nil {
+		if d, ok := jr.Defaults.RequestDefaultsFor("sonarr", srv.ID); ok {
+			profileID, folder, langID = d.ProfileID, d.RootFolder, d.LanguageProfileID
+		}
+	}
 	for i, p := range srv.Profiles {
-		if p.ID == srv.ActiveProfileID {
+		if p.ID == profileID {
 			jr.selectedProfile = i
 			break
 		}
 	}
 	for i, f := range srv.RootFolders {
-		if f.Path == srv.ActiveDirectory {
+		if f.Path == folder {
 			jr.selectedFolder = i
 			break
 		}
 	}
 	for i, l := range srv.LanguageProfiles {
-		if l.ID == srv.ActiveLanguageProfileID {
+		if l.ID == langID {
 			jr.selectedLang = i
 			break
 		}
 	}
 }
 
+// rememberOptions stores the chosen server and options as defaults for the next request.
+func (jr *JellyseerrRequestScreen) rememberOptions(opts *jellyseerr.RequestOptions) {
+	if jr.Defaults == nil || opts == nil {
+		return
+	}
+	service := "sonarr"
+	if jr.result.MediaType == "movie" {
+		service = "radarr"
+	}
+	jr.Defaults.RememberRequest(service, opts.Is4K, opts.ServerID, config.RequestDefaults{
+		ProfileID:         opts.ProfileID,
+		RootFolder:        opts.RootFolder,
+		LanguageProfileID: opts.LanguageProfileID,
+	})
+	if jr.OnDefaultsChanged != nil {
+		jr.OnDefaultsChanged()
+	}
+}
+
 func (jr *JellyseerrRequestScreen) updateButtons() {
 	jr.buttons = nil
 	if jr.status < jellyseerr.StatusPending {
 		jr.buttons = append(jr.buttons, "Request")
+	} else if jr.result.MediaType == "tv" && len(jr.missingSeasons()) > 0 {
+		jr.buttons = append(jr.buttons, jr.missingButtonLabel())
 	}
-	if jr.trailerURL != "" {
-		jr.buttons = append(jr.buttons, "Trailer")
+	if len(jr.trailers) > 0 {
+		jr.buttons = append(jr.buttons, jr.trailerButtonLabel())
 	}
 	jr.buttons = append(jr.buttons, "Back")
 	if jr.buttonIndex >= len(jr.buttons) {
@@ -266,7 +477,7 @@ func (jr *JellyseerrRequestScreen) optionRowCount() int {
 			count++ // folder
 		}
 		count++ // 4K toggle
-		return count
+		return count + jr.userRowCount()
 	}
 	// TV
 	count := 0
@@ -287,7 +498,15 @@ func (jr *JellyseerrRequestScreen) optionRowCount() int {
 		count++
 	}
 	count++ // 4K toggle
-	return count
+	return count + jr.userRowCount()
+}
+
+// userRowCount is 1 when the "Request As" row is shown, after the 4K toggle.
+func (jr *JellyseerrRequestScreen) userRowCount() int {
+	if len(jr.users) > 1 {
+		return 1
+	}
+	return 0
 }
 
 func (jr *JellyseerrRequestScreen) hasMultipleRadarrServers() bool {
@@ -335,10 +554,21 @@ func (jr *JellyseerrRequestScreen) Update() (*ScreenTransition, error) {
 
 	dir, enter, back := InputState()
 
+	if jr.trailerPicking {
+		jr.updateTrailerPicker(dir, enter, back)
+		return nil, nil
+	}
+	if back && jr.confirmMissing {
+		jr.setConfirmMissing(false)
+		return nil, nil
+	}
 	if back || jr.wantBack {
 		jr.wantBack = false
 		return &ScreenTransition{Type: TransitionPop}, nil
 	}
+	if dir != DirNone && jr.confirmMissing {
+		jr.setConfirmMissing(false)
+	}
 
 	// Mouse click handling
 	mx, my, clicked := MouseJustClicked()
@@ -455,6 +685,9 @@ func (jr *JellyseerrRequestScreen) optionRowType(row int) string {
 		if row == cur {
 			return "4k"
 		}
+		if row == cur+1 && jr.userRowCount() > 0 {
+			return "user"
+		}
 		return ""
 	}
 	// TV
@@ -489,6 +722,9 @@ func (jr *JellyseerrRequestScreen) optionRowType(row int) string {
 	if row == cur {
 		return "4k"
 	}
+	if row == cur+1 && jr.userRowCount() > 0 {
+		return "user"
+	}
 	return ""
 }
 
@@ -500,13 +736,13 @@ func (jr *JellyseerrRequestScreen) cycleOption(delta int) {
 			jr.selectedServer = wrapIndex(jr.selectedServer+delta, len(jr.radarrServers))
 			jr.selectedProfile = 0
 			jr.selectedFolder = 0
-			jr.preselectRadarrDefaults()
+			jr.preselectRadarrOptions()
 		} else {
 			jr.selectedServer = wrapIndex(jr.selectedServer+delta, len(jr.sonarrServers))
 			jr.selectedProfile = 0
 			jr.selectedFolder = 0
 			jr.selectedLang = 0
-			jr.preselectSonarrDefaults()
+			jr.preselectSonarrOptions()
 		}
 	case "profile":
 		if jr.result.MediaType == "movie" {
@@ -534,7 +770,36 @@ func (jr *JellyseerrRequestScreen) cycleOption(delta int) {
 		}
 	case "4k":
 		jr.is4K = !jr.is4K
+		// Switch to the server (and options) used last time for this quality
+		jr.selectedProfile, jr.selectedFolder, jr.selectedLang = 0, 0, 0
+		if jr.result.MediaType == "movie" {
+			jr.preselectRadarrDefaults()
+		} else {
+			jr.preselectSonarrDefaults()
+		}
+	case "user":
+		jr.selectedUser = wrapIndex(jr.selectedUser+delta, len(jr.users))
+	}
+}
+
+// formatSeasonList formats season numbers as "seasons 2, 4 and 5".
+func formatSeasonList(seasons []int) string {
+	if len(seasons) == 1 {
+		return fmt.Sprintf("season %d", seasons[0])
+	}
+	var b strings.Builder
+	b.WriteString("seasons ")
+	for i, n := range seasons {
+		switch {
+		case i == 0:
+		case i == len(seasons)-1:
+			b.WriteString(" and ")
+		default:
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%d", n)
 	}
+	return b.String()
 }
 
 func wrapIndex(i, n int) int {
@@ -544,23 +809,91 @@ func wrapIndex(i, n int) int {
 	return ((i % n) + n) % n
 }
 
+// setConfirmMissing switches the "Request Missing" button in or out of its confirm state.
+func (jr *JellyseerrRequestScreen) setConfirmMissing(confirm bool) {
+	jr.confirmMissing = confirm
+	jr.updateButtons()
+}
+
 func (jr *JellyseerrRequestScreen) handleButton() {
 	btn := jr.buttons[jr.buttonIndex]
+	if btn != jr.missingButtonLabel() && jr.confirmMissing {
+		jr.setConfirmMissing(false)
+	}
 	switch btn {
+	case jr.missingButtonLabel():
+		if jr.requesting {
+			return
+		}
+		if !jr.confirmMissing {
+			jr.setConfirmMissing(true)
+			return
+		}
+		seasons := jr.missingSeasons()
+		jr.setConfirmMissing(false)
+		opts := jr.buildRequestOptions()
+		jr.rememberOptions(opts)
+		jr.requesting = true
+		jr.reqError = ""
+		jr.reqSuccess = ""
+		go jr.submitRequest(seasons, opts)
 	case "Request":
 		if jr.requesting {
 			return
 		}
+		jr.rememberOptions(jr.buildRequestOptions())
 		go jr.doRequest()
-	case "Trailer":
-		if jr.OnPlayTrailer != nil && jr.trailerURL != "" {
-			jr.OnPlayTrailer(jr.trailerURL)
+	case jr.trailerButtonLabel():
+		if len(jr.trailers) > 1 {
+			jr.trailerPicking = true
+			jr.trailerIndex = 0
+		} else {
+			jr.playTrailer(0)
 		}
 	case "Back":
 		jr.wantBack = true
 	}
 }
 
+// playTrailer plays trailer i and closes the trailer list.
+func (jr *JellyseerrRequestScreen) playTrailer(i int) {
+	jr.trailerPicking = false
+	if jr.OnPlayTrailer != nil && i < len(jr.trailers) {
+		jr.OnPlayTrailer(jr.trailers[i].YouTubeURL())
+	}
+}
+
+// updateTrailerPicker handles input while the trailer list is open.
+func (jr *JellyseerrRequestScreen) updateTrailerPicker(dir Direction, enter, back bool) {
+	if back {
+		jr.trailerPicking = false
+		return
+	}
+	if mx, my, clicked := MouseJustClicked(); clicked {
+		for i, r := range jr.trailerRects {
+			if r.Hit(mx, my) {
+				jr.playTrailer(i)
+				return
+			}
+		}
+		jr.trailerPicking = false
+		return
+	}
+	switch dir {
+	case DirUp:
+		if jr.trailerIndex > 0 {
+			jr.trailerIndex--
+		}
+	case DirDown:
+		if jr.trailerIndex < len(jr.trailers)-1 {
+			jr.trailerIndex++
+		}
+	}
+	if enter {
+		jr.playTrailer(jr.trailerIndex)
+	}
+}
+
 func (jr *JellyseerrRequestScreen) buildRequestOptions() *jellyseerr.RequestOptions {
 	if !jr.servicesLoaded {
 		return nil
@@ -596,6 +929,11 @@ func (jr *JellyseerrRequestScreen) buildRequestOptions() *jellyseerr.RequestOpti
 			opts.LanguageProfileID = srv.LanguageProfiles[jr.selectedLang].ID
 		}
 	}
+	if jr.selectedUser < len(jr.users) {
+		if id := jr.users[jr.selectedUser].ID; id != jr.currentUserID {
+			opts.UserID = id
+		}
+	}
 	return opts
 }
 
@@ -623,7 +961,13 @@ func (jr *JellyseerrRequestScreen) doRequest() {
 	opts := jr.buildRequestOptions()
 	jr.mu.Unlock()
 
-	_, err := jr.client.CreateRequest(jr.result.ID, mediaType, seasons, opts)
+	jr.submitRequest(seasons, opts)
+}
+
+// submitRequest creates the request and reports the outcome. The caller sets
+// jr.requesting beforehand.
+func (jr *JellyseerrRequestScreen) submitRequest(seasons []int, opts *jellyseerr.RequestOptions) {
+	_, err := jr.client.CreateRequest(jr.result.ID, jr.result.MediaType, seasons, opts)
 
 	jr.mu.Lock()
 	defer jr.mu.Unlock()
@@ -634,8 +978,15 @@ func (jr *JellyseerrRequestScreen) doRequest() {
 		return
 	}
 
-	jr.reqSuccess = "Request submitted!"
-	jr.status = jellyseerr.StatusPending
+	if jr.status < jellyseerr.StatusPending {
+		jr.reqSuccess = "Request submitted!"
+		jr.status = jellyseerr.StatusPending
+	} else {
+		// Partially available show: refresh per-season status so the
+		// requested seasons drop out of "missing"
+		jr.reqSuccess = fmt.Sprintf("Requested %d seasons!", len(seasons))
+		go jr.loadTVDetail()
+	}
 	jr.updateButtons()
 }
 
@@ -760,6 +1111,8 @@ func (jr *JellyseerrRequestScreen) Draw(dst *ebiten.Image) {
 
 	if jr.requesting {
 		DrawText(dst, "Requesting...", btnX+20, btnY+8, FontSizeSmall, ColorTextSecondary)
+	} else if jr.confirmMissing {
+		DrawText(dst, "Will request "+formatSeasonList(jr.missingSeasons()), infoX, btnY+48, FontSizeSmall, ColorTextSecondary)
 	}
 
 	// --- Request options section (below poster area) ---
@@ -808,6 +1161,49 @@ func (jr *JellyseerrRequestScreen) Draw(dst *ebiten.Image) {
 		}
 	}
 
+	if jr.trailerPicking {
+		jr.drawTrailerPicker(dst)
+	}
+}
+
+// drawTrailerPicker draws the trailer list below the trailer button.
+func (jr *JellyseerrRequestScreen) drawTrailerPicker(dst *ebiten.Image) {
+	var anchor ButtonRect
+	for i, label := range jr.buttons {
+		if label == jr.trailerButtonLabel() && i < len(jr.buttonRects) {
+			anchor = jr.buttonRects[i]
+		}
+	}
+	const rowH, pad = 36.0, 6.0
+	w := 0.0
+	for _, v := range jr.trailers {
+		tw, _ := MeasureText(v.Name+"   "+v.Type, FontSizeBody)
+		w = max(w, tw+32)
+	}
+	x, y := anchor.X, anchor.Y+anchor.H+4
+	h := float64(len(jr.trailers))*rowH + pad*2
+	vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), float32(h), ColorSurface, false)
+	vector.StrokeRect(dst, float32(x), float32(y), float32(w), float32(h), 1, ColorPrimary, false)
+
+	jr.trailerRects = make([]ButtonRect, len(jr.trailers))
+	for i, v := range jr.trailers {
+		ry := y + pad + float64(i)*rowH
+		jr.trailerRects[i] = ButtonRect{X: x, Y: ry, W: w, H: rowH}
+		clr := ColorTextSecondary
+		if i == jr.trailerIndex {
+			vector.DrawFilledRect(dst, float32(x), float32(ry), float32(w), rowH, ColorSurfaceHover, false)
+			clr = ColorText
+		}
+		name := v.Name
+		if name == "" {
+			name = v.Type
+		}
+		DrawText(dst, name, x+16, ry+8, FontSizeBody, clr)
+		if v.Type != "" && !strings.Contains(name, v.Type) {
+			tw, _ := MeasureText(name, FontSizeBody)
+			DrawText(dst, v.Type, x+16+tw+16, ry+8, FontSizeSmall, ColorTextMuted)
+		}
+	}
 }
 
 // drawOptions draws the option rows and returns the Y position after the last row.
@@ -897,6 +1293,11 @@ func (jr *JellyseerrRequestScreen) optionLabelValue(kind string) (string, string
 			val = "Yes"
 		}
 		return "4K", val
+	case "user":
+		if jr.selectedUser < len(jr.users) {
+			return "Request As", jr.users[jr.selectedUser].DisplayName
+		}
+		return "Request As", "—"
 	}
 	return "", ""
 }
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/depeter/jellycouch/internal/jellyseerr"
+)
+
+func TestMissingSeasons(t *testing.T) {
+	seasons := []jellyseerr.Season{{SeasonNumber: 0}, {SeasonNumber: 1}, {SeasonNumber: 2}, {SeasonNumber: 3}}
+	tests := []struct {
+		name string
+		info *jellyseerr.MediaInfo
+		want []int
+	}{
+		{
+			name: "without season info every regular season",
+			info: &jellyseerr.MediaInfo{Status: jellyseerr.StatusPartiallyAvailable},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "seasons neither available nor requested",
+			info: &jellyseerr.MediaInfo{
+				Status: jellyseerr.StatusPartiallyAvailable,
+				Seasons: []jellyseerr.SeasonStatus{
+					{SeasonNumber: 1, Status: jellyseerr.StatusAvailable},
+					{SeasonNumber: 2, Status: jellyseerr.StatusUnknown},
+				},
+				Requests: []jellyseerr.MediaRequest{
+					{Seasons: []jellyseerr.SeasonStatus{{SeasonNumber: 3}}},
+				},
+			},
+			want: []int{2},
+		},
+		{
+			name: "not partially available",
+			info: &jellyseerr.MediaInfo{Status: jellyseerr.StatusAvailable},
+			want: nil,
+		},
+		{
+			name: "not in Jellyseerr yet",
+			info: nil,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jr := &JellyseerrRequestScreen{tvDetail: &jellyseerr.TVDetail{Seasons: seasons, MediaInfo: tt.info}}
+			if got := jr.missingSeasons(); !slices.Equal(got, tt.want) {
+				t.Errorf("missingSeasons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
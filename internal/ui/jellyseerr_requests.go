@@ -3,7 +3,9 @@ package ui
 import (
 	"fmt"
 	"image/color"
+	"log"
 	"sync"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
@@ -29,6 +31,18 @@ type JellyseerrRequestsScreen struct {
 
 	filterIndex int
 	focusMode   int // 0=filter tabs, 1=request list
+	// "Mine only" toggle after the status tabs, shown once the current user is known
+	userID      int
+	mineOnly    bool
+	mineFocused bool
+	mineRect    ButtonRect
+
+	// Approve/Decline on pending requests, for users who can manage requests
+	canManage   bool
+	acting      bool
+	actionError string
+	approveRect ButtonRect
+	declineRect ButtonRect
 	loading     bool
 	loadError   string
 
@@ -40,6 +54,7 @@ type JellyseerrRequestsScreen struct {
 	OnSearch       func()
 
 	errDisplay ErrorDisplay
+	emptyState EmptyState
 	mu         sync.Mutex
 }
 
@@ -63,6 +78,25 @@ func (jr *JellyseerrRequestsScreen) Name() string { return "My Requests" }
 
 func (jr *JellyseerrRequestsScreen) OnEnter() {
 	go jr.loadRequests()
+	jr.mu.Lock()
+	needUser := jr.userID == 0
+	jr.mu.Unlock()
+	if needUser {
+		go jr.loadCurrentUser()
+	}
+}
+
+// loadCurrentUser looks up the Jellyseerr user for the "Mine only" filter.
+func (jr *JellyseerrRequestsScreen) loadCurrentUser() {
+	user, err := jr.client.GetCurrentUser()
+	if err != nil {
+		log.Printf("Jellyseerr current user: %v", err)
+		return
+	}
+	jr.mu.Lock()
+	jr.userID = user.ID
+	jr.canManage = user.CanManageRequests()
+	jr.mu.Unlock()
 }
 
 func (jr *JellyseerrRequestsScreen) OnExit() {}
@@ -72,9 +106,13 @@ func (jr *JellyseerrRequestsScreen) loadRequests() {
 	jr.loading = true
 	jr.loadError = ""
 	filter := requestFilters[jr.filterIndex]
+	requestedBy := 0
+	if jr.mineOnly {
+		requestedBy = jr.userID
+	}
 	jr.mu.Unlock()
 
-	requests, total, err := jr.client.GetRequests(filter, 40, 0)
+	requests, total, err := jr.client.GetRequests(filter, requestedBy, 40, 0)
 
 	jr.mu.Lock()
 	defer jr.mu.Unlock()
@@ -94,13 +132,13 @@ func (jr *JellyseerrRequestsScreen) loadRequests() {
 	jr.gridItems = make([]GridItem, len(requests))
 	for i, req := range requests {
 		title := fmt.Sprintf("Request #%d", req.ID)
-		var subtitle string
-		if req.Type == "movie" {
-			subtitle = "Movie"
-		} else {
-			subtitle = "TV"
+		subtitle := formatRequestDate(req.CreatedAt, FormatShortDate)
+		if name := req.RequestedBy.DisplayName; name != "" {
+			if subtitle != "" {
+				subtitle += " \u2022 "
+			}
+			subtitle += name
 		}
-		subtitle += " \u2022 " + jellyseerr.RequestStatusLabel(req.Status)
 
 		jr.gridItems[i] = GridItem{
 			ID:            fmt.Sprintf("%d", req.ID),
@@ -192,6 +230,101 @@ func (jr *JellyseerrRequestsScreen) fetchPosterForSelect(req jellyseerr.MediaReq
 	return result
 }
 
+// formatRequestDate formats an ISO 8601 timestamp from the API in local time
+// with format. Returns "" if the timestamp can't be parsed.
+func formatRequestDate(iso string, format func(time.Time) string) string {
+	t, err := time.Parse(time.RFC3339, iso)
+	if err != nil {
+		return ""
+	}
+	return format(t.Local())
+}
+
+// formatDateTime formats t as a full date and time of day.
+func formatDateTime(t time.Time) string {
+	return FormatDate(t) + " " + FormatClock(t)
+}
+
+// requestInfoLine describes the focused request: type, status, requester and date.
+func requestInfoLine(req jellyseerr.MediaRequest) string {
+	line := "TV"
+	if req.Type == "movie" {
+		line = "Movie"
+	}
+	line += " \u2022 " + jellyseerr.RequestStatusLabel(req.Status)
+	if name := req.RequestedBy.DisplayName; name != "" {
+		line += " \u2022 Requested by " + name
+	}
+	if date := formatRequestDate(req.CreatedAt, formatDateTime); date != "" {
+		line += " on " + date
+	}
+	return line
+}
+
+// canActOnFocused reports whether Approve/Decline apply to the focused request.
+func (jr *JellyseerrRequestsScreen) canActOnFocused() bool {
+	if !jr.canManage || jr.acting || jr.focusMode != 1 || jr.grid.Focused >= len(jr.requests) {
+		return false
+	}
+	return jr.requests[jr.grid.Focused].Status == jellyseerr.RequestPending
+}
+
+// actOnFocused approves the focused request, or asks before declining it,
+// then reloads the list. Caller must hold jr.mu.
+func (jr *JellyseerrRequestsScreen) actOnFocused(approve bool) *ScreenTransition {
+	id := jr.requests[jr.grid.Focused].ID
+	if approve {
+		jr.act(id, true)
+		return nil
+	}
+	title := ""
+	if jr.grid.Focused < len(jr.gridItems) {
+		title = jr.gridItems[jr.grid.Focused].Title
+	}
+	return &ScreenTransition{Type: TransitionConfirm, Confirm: &ConfirmDialog{
+		Title:        T("confirm.decline_title"),
+		Message:      Tf("confirm.decline_message", title),
+		ConfirmLabel: T("confirm.decline"),
+		OnConfirm: func() {
+			jr.mu.Lock()
+			defer jr.mu.Unlock()
+			if !jr.acting {
+				jr.act(id, false)
+			}
+		},
+	}}
+}
+
+// act approves or declines request id in the background, then reloads the
+// list. Caller must hold jr.mu.
+func (jr *JellyseerrRequestsScreen) act(id int, approve bool) {
+	jr.acting = true
+	jr.actionError = ""
+	go func() {
+		var err error
+		if approve {
+			err = jr.client.ApproveRequest(id)
+		} else {
+			err = jr.client.DeclineRequest(id)
+		}
+		jr.mu.Lock()
+		jr.acting = false
+		if err != nil {
+			jr.actionError = err.Error()
+		}
+		jr.mu.Unlock()
+		if err == nil {
+			jr.loadRequests()
+		}
+	}()
+}
+
+// toggleMine switches between everyone's requests and the current user's.
+func (jr *JellyseerrRequestsScreen) toggleMine() {
+	jr.mineOnly = !jr.mineOnly
+	go jr.loadRequests()
+}
+
 // requestToMediaStatus maps a request status to a media status for badge display.
 func (jr *JellyseerrRequestsScreen) requestToMediaStatus(reqStatus int) int {
 	switch reqStatus {
@@ -240,9 +373,28 @@ func (jr *JellyseerrRequestsScreen) Update() (*ScreenTransition, error) {
 					go jr.loadRequests()
 				}
 				jr.focusMode = 0
+				jr.mineFocused = false
+				return nil, nil
+			}
+		}
+		if jr.userID != 0 {
+			r := jr.mineRect
+			if PointInRect(mx, my, r.X, r.Y, r.W, r.H) {
+				jr.focusMode = 0
+				jr.mineFocused = true
+				jr.toggleMine()
 				return nil, nil
 			}
 		}
+		// Check approve/decline buttons
+		if jr.canActOnFocused() {
+			if r := jr.approveRect; PointInRect(mx, my, r.X, r.Y, r.W, r.H) {
+				return jr.actOnFocused(true), nil
+			}
+			if r := jr.declineRect; PointInRect(mx, my, r.X, r.Y, r.W, r.H) {
+				return jr.actOnFocused(false), nil
+			}
+		}
 		// Check search button
 		searchX := jr.searchBtnX()
 		if PointInRect(mx, my, searchX, reqsSearchBtnY, reqsSearchBtnW, reqsSearchBtnH) {
@@ -251,6 +403,12 @@ func (jr *JellyseerrRequestsScreen) Update() (*ScreenTransition, error) {
 			}
 			return nil, nil
 		}
+		if jr.showsEmpty() && jr.emptyState.HandleClick(mx, my) {
+			if jr.OnSearch != nil {
+				jr.OnSearch()
+			}
+			return nil, nil
+		}
 		// Check grid items
 		if len(jr.gridItems) > 0 {
 			baseY := float64(NavBarHeight) + 110.0 - jr.ScrollY
@@ -273,28 +431,43 @@ func (jr *JellyseerrRequestsScreen) Update() (*ScreenTransition, error) {
 
 	switch jr.focusMode {
 	case 0: // filter tabs
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		if KeyJustPressed(ebiten.KeyArrowUp) {
 			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
 		}
 		dir, _, _ := InputState()
 		switch dir {
 		case DirLeft:
-			if jr.filterIndex > 0 {
+			if jr.mineFocused {
+				jr.mineFocused = false
+			} else if jr.filterIndex > 0 {
 				jr.filterIndex--
 				go jr.loadRequests()
 			}
 		case DirRight:
-			if jr.filterIndex < len(requestFilters)-1 {
+			if jr.filterIndex < len(requestFilters)-1 && !jr.mineFocused {
 				jr.filterIndex++
 				go jr.loadRequests()
+			} else if jr.userID != 0 {
+				jr.mineFocused = true
 			}
 		case DirDown:
-			if len(jr.gridItems) > 0 {
+			if len(jr.gridItems) > 0 || jr.showsEmpty() {
 				jr.focusMode = 1
 			}
 		}
+		if enter && jr.mineFocused {
+			jr.toggleMine()
+		}
 
-	case 1: // request list
+	case 1: // request list, or the empty state's search button
+		if len(jr.gridItems) == 0 {
+			if KeyJustPressed(ebiten.KeyArrowUp) {
+				jr.focusMode = 0
+			} else if enter && jr.showsEmpty() && jr.OnSearch != nil {
+				jr.OnSearch()
+			}
+			return nil, nil
+		}
 		dir, _, _ := InputState()
 		if dir != DirNone {
 			if dir == DirUp && jr.grid.FocusedRow() == 0 {
@@ -306,11 +479,24 @@ func (jr *JellyseerrRequestsScreen) Update() (*ScreenTransition, error) {
 		if enter {
 			jr.selectRequest(jr.grid.Focused)
 		}
+		if jr.canActOnFocused() {
+			if inpututil.IsKeyJustPressed(ebiten.KeyA) {
+				return jr.actOnFocused(true), nil
+			} else if inpututil.IsKeyJustPressed(ebiten.KeyD) {
+				return jr.actOnFocused(false), nil
+			}
+		}
 	}
 
 	return nil, nil
 }
 
+// showsEmpty reports whether the list loaded with no requests, so the empty
+// state's search button is on screen. Caller must hold jr.mu.
+func (jr *JellyseerrRequestsScreen) showsEmpty() bool {
+	return !jr.loading && jr.loadError == "" && len(jr.gridItems) == 0
+}
+
 func (jr *JellyseerrRequestsScreen) selectRequest(idx int) {
 	if idx >= len(jr.requests) || jr.OnItemSelected == nil {
 		return
@@ -348,7 +534,7 @@ func (jr *JellyseerrRequestsScreen) Draw(dst *ebiten.Image) {
 		jr.filterRects[i] = ButtonRect{X: tabX, Y: tabY, W: tabW, H: tabH}
 
 		if i == jr.filterIndex {
-			if jr.focusMode == 0 {
+			if jr.focusMode == 0 && !jr.mineFocused {
 				vector.DrawFilledRect(dst, float32(tabX-4), float32(tabY-4),
 					float32(tabW+8), float32(tabH+8), ColorSurfaceHover, false)
 			}
@@ -361,8 +547,34 @@ func (jr *JellyseerrRequestsScreen) Draw(dst *ebiten.Image) {
 		tabX += tabW + 16
 	}
 
+	if jr.userID != 0 {
+		label := "Mine only: " + onOff(jr.mineOnly)
+		w, _ := MeasureText(label, FontSizeBody)
+		mx := tabX + 24
+		mw := w + 16
+		mh := FontSizeBody + 12.0
+		jr.mineRect = ButtonRect{X: mx, Y: tabY, W: mw, H: mh}
+		vector.DrawFilledRect(dst, float32(tabX+4), float32(tabY+4), 1, float32(mh-8), ColorTextMuted, false)
+		if jr.focusMode == 0 && jr.mineFocused {
+			vector.DrawFilledRect(dst, float32(mx-4), float32(tabY-4),
+				float32(mw+8), float32(mh+8), ColorSurfaceHover, false)
+		}
+		clr := ColorTextMuted
+		if jr.mineOnly {
+			clr = ColorPrimary
+		}
+		DrawText(dst, label, mx+8, tabY+4, FontSizeBody, clr)
+	}
+
 	baseY := float64(NavBarHeight) + 110.0
 
+	if jr.canActOnFocused() || jr.acting {
+		jr.drawActionButtons(dst, tabY)
+	}
+	if jr.actionError != "" {
+		DrawText(dst, jr.actionError, SectionPadding, baseY-44, FontSizeSmall, ColorError)
+	}
+
 	if jr.loading {
 		DrawTextCentered(dst, "Loading requests...", float64(ScreenWidth)/2, baseY+100,
 			FontSizeHeading, ColorTextSecondary)
@@ -375,13 +587,19 @@ func (jr *JellyseerrRequestsScreen) Draw(dst *ebiten.Image) {
 	}
 
 	if len(jr.gridItems) == 0 {
-		DrawTextCentered(dst, "No requests found", float64(ScreenWidth)/2, baseY+100,
-			FontSizeHeading, ColorTextSecondary)
+		jr.emptyState.Draw(dst, "No requests found", "Search to request", jr.focusMode == 1,
+			float64(ScreenWidth)/2, baseY+100)
 		return
 	}
 
-	// Total count
-	DrawText(dst, fmt.Sprintf("%d requests", jr.total), SectionPadding, baseY-20, FontSizeSmall, ColorTextMuted)
+	// Total count, then details of the focused request
+	countStr := fmt.Sprintf("%s requests", FormatCount(jr.total))
+	DrawText(dst, countStr, SectionPadding, baseY-20, FontSizeSmall, ColorTextMuted)
+	if jr.focusMode == 1 && jr.grid.Focused < len(jr.requests) {
+		cw, _ := MeasureText(countStr, FontSizeSmall)
+		info := requestInfoLine(jr.requests[jr.grid.Focused])
+		DrawText(dst, info, SectionPadding+cw+24, baseY-20, FontSizeSmall, ColorTextSecondary)
+	}
 
 	for i, item := range jr.gridItems {
 		x, iy := jr.grid.ItemRect(i, SectionPadding, baseY-jr.ScrollY)
@@ -393,5 +611,35 @@ func (jr *JellyseerrRequestsScreen) Draw(dst *ebiten.Image) {
 		isFocused := jr.focusMode == 1 && i == jr.grid.Focused
 		drawPosterItem(dst, item, x, iy, isFocused)
 	}
+	jr.SetGridRows((len(jr.gridItems)+jr.grid.Cols-1)/jr.grid.Cols, jr.grid.RowHeight(), baseY, 24)
+}
 
+// drawActionButtons draws Approve/Decline for the focused pending request,
+// right-aligned on the filter tab row.
+func (jr *JellyseerrRequestsScreen) drawActionButtons(dst *ebiten.Image, y float64) {
+	const btnW, btnH, gap = 130.0, FontSizeBody + 12.0, 12.0
+	x := float64(ScreenWidth) - SectionPadding - btnW*2 - gap
+	jr.approveRect = ButtonRect{X: x, Y: y, W: btnW, H: btnH}
+	jr.declineRect = ButtonRect{X: x + btnW + gap, Y: y, W: btnW, H: btnH}
+
+	approveLabel, declineLabel := "Approve (A)", "Decline (D)"
+	if jr.acting {
+		approveLabel, declineLabel = "Working...", ""
+	}
+	for _, b := range []struct {
+		rect  ButtonRect
+		label string
+		clr   color.Color
+	}{
+		{jr.approveRect, approveLabel, ColorSuccess},
+		{jr.declineRect, declineLabel, ColorError},
+	} {
+		if b.label == "" {
+			continue
+		}
+		r := b.rect
+		vector.DrawFilledRect(dst, float32(r.X), float32(r.Y), float32(r.W), float32(r.H), ColorSurface, false)
+		vector.StrokeRect(dst, float32(r.X), float32(r.Y), float32(r.W), float32(r.H), 1, b.clr, false)
+		DrawTextCentered(dst, b.label, r.X+r.W/2, r.Y+r.H/2, FontSizeSmall, b.clr)
+	}
 }
@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/depeter/jellycouch/internal/cache"
@@ -13,6 +12,8 @@ import (
 )
 
 // JellyseerrSearchScreen lets users search Jellyseerr for media to request.
+// Matching people are listed too; selecting one passes a "person" result to
+// OnResultSelected.
 type JellyseerrSearchScreen struct {
 	client   *jellyseerr.Client
 	imgCache *cache.ImageCache
@@ -21,10 +22,15 @@ type JellyseerrSearchScreen struct {
 	results   []jellyseerr.SearchResult
 	gridItems []GridItem
 	grid      *FocusGrid
-	focusMode int // 0=search bar, 1=results
+	focusMode int // 0=search bar, 1=results, 2=people row
 	searchErr string
 	searching bool
 
+	// People matching the query, shown as a row above the results
+	people       []jellyseerr.SearchResult
+	peopleRow    *PosterGrid
+	resultsBaseY float64 // set during Draw, used for mouse hit testing
+
 	ScrollState
 
 	OnResultSelected func(result jellyseerr.SearchResult)
@@ -46,6 +52,16 @@ func (js *JellyseerrSearchScreen) Name() string { return "Search" }
 func (js *JellyseerrSearchScreen) OnEnter()     {}
 func (js *JellyseerrSearchScreen) OnExit()      {}
 
+func (js *JellyseerrSearchScreen) EditText(edit func(ti *TextInput)) bool {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	if js.focusMode != 0 {
+		return false
+	}
+	edit(&js.input)
+	return true
+}
+
 func (js *JellyseerrSearchScreen) Update() (*ScreenTransition, error) {
 	js.mu.Lock()
 	defer js.mu.Unlock()
@@ -57,12 +73,13 @@ func (js *JellyseerrSearchScreen) Update() (*ScreenTransition, error) {
 			js.focusMode = 0
 			return nil, nil
 		}
+		if js.focusMode == 2 {
+			js.focusMode = 0
+			return nil, nil
+		}
 		if js.focusMode == 0 && js.input.Text != "" {
 			js.input.Clear()
-			js.results = nil
-			js.gridItems = nil
-			js.grid.SetTotal(0)
-			js.searchErr = ""
+			js.clearResults()
 			return nil, nil
 		}
 		return &ScreenTransition{Type: TransitionPop}, nil
@@ -83,18 +100,25 @@ func (js *JellyseerrSearchScreen) Update() (*ScreenTransition, error) {
 		if PointInRect(mx, my, barX, barY, barW, barH) {
 			if js.input.Text != "" && PointInRect(mx, my, barX+barW-40, barY, 40, barH) {
 				js.input.Clear()
-				js.results = nil
-				js.gridItems = nil
-				js.grid.SetTotal(0)
-				js.searchErr = ""
+				js.clearResults()
 			}
 			js.focusMode = 0
 			return nil, nil
 		}
+		if js.peopleRow != nil {
+			if idx, ok := js.peopleRow.HandleClick(mx, my); ok {
+				js.setFocusMode(2)
+				js.peopleRow.Focused = idx
+				if js.OnResultSelected != nil {
+					js.OnResultSelected(js.people[idx])
+				}
+				return nil, nil
+			}
+		}
 		if len(js.gridItems) > 0 {
-			resultBaseY := barY + barH + 40 - js.ScrollY
+			resultBaseY := js.resultsBaseY - js.ScrollY
 			if idx, ok := js.grid.HandleClick(mx, my, SectionPadding, resultBaseY); ok {
-				js.focusMode = 1
+				js.setFocusMode(1)
 				js.grid.Focused = idx
 				if idx < len(js.results) && js.OnResultSelected != nil {
 					js.OnResultSelected(js.results[idx])
@@ -112,19 +136,45 @@ func (js *JellyseerrSearchScreen) Update() (*ScreenTransition, error) {
 			go js.doSearch()
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		if KeyJustPressed(ebiten.KeyArrowUp) {
 			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) && len(js.results) > 0 {
-			js.focusMode = 1
+		if KeyJustPressed(ebiten.KeyArrowDown) {
+			if js.peopleRow != nil {
+				js.setFocusMode(2)
+			} else if len(js.results) > 0 {
+				js.setFocusMode(1)
+			}
+		}
+
+	case 2: // people row
+		dir, _, _ := InputState()
+		switch dir {
+		case DirUp:
+			js.setFocusMode(0)
+		case DirDown:
+			if len(js.results) > 0 {
+				js.setFocusMode(1)
+			}
+		case DirLeft, DirRight:
+			js.peopleRow.Update(dir)
+		}
+		if enter && js.OnResultSelected != nil {
+			if idx := js.peopleRow.Focused; idx < len(js.people) {
+				js.OnResultSelected(js.people[idx])
+			}
 		}
 
 	case 1: // results grid
 		dir, _, _ := InputState()
 		if dir != DirNone {
 			if dir == DirUp && js.grid.FocusedRow() == 0 {
-				js.focusMode = 0
+				if js.peopleRow != nil {
+					js.setFocusMode(2)
+				} else {
+					js.setFocusMode(0)
+				}
 			} else {
 				js.grid.Update(dir)
 			}
@@ -141,6 +191,24 @@ func (js *JellyseerrSearchScreen) Update() (*ScreenTransition, error) {
 	return nil, nil
 }
 
+// setFocusMode moves focus between the search bar, results and people row.
+func (js *JellyseerrSearchScreen) setFocusMode(mode int) {
+	js.focusMode = mode
+	if js.peopleRow != nil {
+		js.peopleRow.Active = mode == 2
+	}
+}
+
+// clearResults drops the results of the previous search.
+func (js *JellyseerrSearchScreen) clearResults() {
+	js.results = nil
+	js.gridItems = nil
+	js.grid.SetTotal(0)
+	js.people = nil
+	js.peopleRow = nil
+	js.searchErr = ""
+}
+
 func (js *JellyseerrSearchScreen) doSearch() {
 	js.mu.Lock()
 	js.searching = true
@@ -159,13 +227,17 @@ func (js *JellyseerrSearchScreen) doSearch() {
 		return
 	}
 
-	// Filter out "person" results
-	var filtered []jellyseerr.SearchResult
+	// Split people from movies and shows
+	var filtered, people []jellyseerr.SearchResult
 	for _, r := range resp.Results {
-		if r.MediaType == "movie" || r.MediaType == "tv" {
+		switch r.MediaType {
+		case "movie", "tv":
 			filtered = append(filtered, r)
+		case "person":
+			people = append(people, r)
 		}
 	}
+	js.setPeople(people)
 
 	js.results = filtered
 	js.grid.SetTotal(len(filtered))
@@ -188,16 +260,54 @@ func (js *JellyseerrSearchScreen) doSearch() {
 		if img := js.imgCache.Get(posterURL); img != nil {
 			js.gridItems[i].Image = img
 		} else {
-			idx := i
+			key := js.gridItems[i]
+			js.imgCache.LoadAsync(posterURL, func(img *ebiten.Image) {
+				js.mu.Lock()
+				defer js.mu.Unlock()
+				// A newer search may have replaced the results
+				setImageFor(js.gridItems, key, img)
+			})
+		}
+	}
+}
+
+// setPeople rebuilds the people row. Caller must hold js.mu.
+func (js *JellyseerrSearchScreen) setPeople(people []jellyseerr.SearchResult) {
+	js.people = people
+	js.peopleRow = nil
+	if len(people) == 0 {
+		if js.focusMode == 2 {
+			js.focusMode = 0
+		}
+		return
+	}
+	row := NewPosterGrid("People")
+	row.Items = make([]GridItem, len(people))
+	for i, p := range people {
+		row.Items[i] = GridItem{
+			ID:       fmt.Sprintf("%d", p.ID),
+			Title:    p.Name,
+			Subtitle: "Person",
+		}
+		posterURL := p.PosterURL()
+		if posterURL == "" {
+			continue
+		}
+		if img := js.imgCache.Get(posterURL); img != nil {
+			row.Items[i].Image = img
+		} else {
+			key := row.Items[i]
 			js.imgCache.LoadAsync(posterURL, func(img *ebiten.Image) {
 				js.mu.Lock()
 				defer js.mu.Unlock()
-				if idx < len(js.gridItems) {
-					js.gridItems[idx].Image = img
+				if js.peopleRow == row {
+					setImageFor(row.Items, key, img)
 				}
 			})
 		}
 	}
+	js.peopleRow = row
+	js.peopleRow.Active = js.focusMode == 2
 }
 
 func (js *JellyseerrSearchScreen) mediaStatus(r jellyseerr.SearchResult) int {
@@ -238,9 +348,12 @@ func (js *JellyseerrSearchScreen) Draw(dst *ebiten.Image) {
 		displayQuery = js.input.Text
 	}
 	if js.input.Text == "" {
-		DrawText(dst, "Search movies & TV shows...", float64(barX+12), float64(barY+12), FontSizeBody, ColorTextMuted)
+		DrawText(dst, "Search movies, TV shows & people...", float64(barX+12), float64(barY+12), FontSizeBody, ColorTextMuted)
 	}
 	if displayQuery != "" {
+		if js.focusMode == 0 {
+			js.input.DrawSelection(dst, float64(barX+12), float64(barY+12), FontSizeBody)
+		}
 		DrawText(dst, displayQuery, float64(barX+12), float64(barY+12), FontSizeBody, ColorText)
 	}
 
@@ -258,15 +371,20 @@ func (js *JellyseerrSearchScreen) Draw(dst *ebiten.Image) {
 	y := float64(barY+barH) + 8
 	if js.searchErr != "" {
 		y += js.errDisplay.Draw(dst, js.searchErr, float64(barX), y, FontSizeSmall)
-	} else if len(js.results) > 0 {
-		DrawText(dst, fmt.Sprintf("%d results", len(js.results)), float64(barX), y, FontSizeSmall, ColorTextMuted)
+	} else if len(js.results) > 0 || len(js.people) > 0 {
+		DrawText(dst, fmt.Sprintf("%s results", FormatCount(len(js.results)+len(js.people))), float64(barX), y, FontSizeSmall, ColorTextMuted)
 		y += FontSizeSmall + 8
 	}
 	y += 8
 
+	if js.peopleRow != nil && !js.searching {
+		y += js.peopleRow.Draw(dst, SectionPadding, y-js.ScrollY) + SectionGap
+	}
+	js.resultsBaseY = y
+
 	if len(js.gridItems) == 0 && !js.searching {
-		if js.input.Text != "" && len(js.results) == 0 && js.searchErr == "" {
-			DrawTextCentered(dst, "No results found", float64(ScreenWidth)/2, y+100,
+		if js.input.Text != "" && len(js.results) == 0 && len(js.people) == 0 && js.searchErr == "" {
+			DrawTextCentered(dst, T("common.no_results"), float64(ScreenWidth)/2, y+100,
 				FontSizeHeading, ColorTextSecondary)
 		}
 		return
@@ -282,4 +400,5 @@ func (js *JellyseerrSearchScreen) Draw(dst *ebiten.Image) {
 		isFocused := js.focusMode == 1 && i == js.grid.Focused
 		drawPosterItem(dst, item, x, iy, isFocused)
 	}
+	js.SetGridRows((len(js.gridItems)+js.grid.Cols-1)/js.grid.Cols, js.grid.RowHeight(), y, 24)
 }
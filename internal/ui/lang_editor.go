@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
@@ -83,12 +82,12 @@ func (le *LangEditor) Update() {
 	// Shift+Up/Down reorders in the selected column
 	shift := ebiten.IsKeyPressed(ebiten.KeyShift)
 	if shift && le.column == 0 && len(le.selected) > 1 {
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) && le.selIndex > 0 {
+		if KeyJustPressed(ebiten.KeyArrowUp) && le.selIndex > 0 {
 			le.selected[le.selIndex], le.selected[le.selIndex-1] = le.selected[le.selIndex-1], le.selected[le.selIndex]
 			le.selIndex--
 			return
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) && le.selIndex < len(le.selected)-1 {
+		if KeyJustPressed(ebiten.KeyArrowDown) && le.selIndex < len(le.selected)-1 {
 			le.selected[le.selIndex], le.selected[le.selIndex+1] = le.selected[le.selIndex+1], le.selected[le.selIndex]
 			le.selIndex++
 			return
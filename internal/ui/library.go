@@ -1,8 +1,10 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -80,6 +82,12 @@ type LibraryScreen struct {
 	client   *jellyfin.Client
 	imgCache *cache.ImageCache
 
+	// loadCtx is cancelled in OnExit, aborting fetches that are wasted once
+	// the screen is gone. Changes the user made don't use it, so leaving
+	// doesn't abort them.
+	loadCtx    context.Context
+	cancelLoad context.CancelFunc
+
 	parentID  string
 	title     string
 	itemTypes []string
@@ -107,15 +115,36 @@ type LibraryScreen struct {
 	// auto-detected collection type (e.g. "tvshows")
 	collectionType string
 
+	// sortOptions index last loaded from or saved to the server's display
+	// preferences, so only a changed sort is saved
+	savedSort int
+	// statusOptions index last restored or reported to OnStatusChanged
+	savedStatus int
+
+	contextMenu *ContextMenu
+
+	// Multi-select mode, for the batch action bar
+	selecting    bool
+	selected     map[string]bool // item IDs
+	barFocused   bool
+	barIndex     int
+	barRects     []ButtonRect
+	playlistMenu *ContextMenu // playlists for the bar's Add to Playlist
+
 	OnItemSelected func(item jellyfin.MediaItem)
+	OnPlay         func(item jellyfin.MediaItem, resumeTicks int64)
+	OnRequest4K    func(tmdbID int, mediaType, title string)
+	// OnStatusChanged is called with the new Status filter value, so the
+	// caller can remember it for the library
+	OnStatusChanged func(status string)
 
 	errDisplay ErrorDisplay
+	emptyState EmptyState
+	typeAhead  typeAhead // letters typed on the grid jump to matching titles
 	mu         sync.Mutex
 }
 
 func NewLibraryScreen(client *jellyfin.Client, imgCache *cache.ImageCache, parentID, title string, itemTypes []string) *LibraryScreen {
-	cols := (ScreenWidth - SectionPadding*2) / (PosterWidth + PosterGap)
-
 	// Build sort option labels
 	sortLabels := make([]string, len(sortOptions))
 	for i, opt := range sortOptions {
@@ -136,7 +165,7 @@ func NewLibraryScreen(client *jellyfin.Client, imgCache *cache.ImageCache, paren
 
 	filterBar := NewFilterBar([]FilterOption{
 		{Label: "Sort", Options: sortLabels, Selected: 2},       // Date Added (New)
-		{Label: "Genre", Options: []string{"All"}, Selected: 0},
+		{Label: "Genre", Options: []string{"All"}, Selected: 0, Multi: true},
 		{Label: "Status", Options: statusLabels, Selected: 1}, // Unplayed
 		{Label: "Letter", Options: letterOptions, Selected: 0},
 		{Label: "Year", Options: yearLabels, Selected: 0},
@@ -148,16 +177,90 @@ func NewLibraryScreen(client *jellyfin.Client, imgCache *cache.ImageCache, paren
 		parentID:  parentID,
 		title:     title,
 		itemTypes: itemTypes,
-		grid:      NewFocusGrid(cols, 0),
+		grid:      NewPosterFocusGrid(0),
 		filterBar: filterBar,
 		focusMode: focusGrid,
 	}
+	ls.savedSort = filterBar.Filters[0].Selected
+	ls.savedStatus = filterBar.Filters[2].Selected
 	ls.filter = ls.buildFilter()
+	ls.loadCtx, ls.cancelLoad = context.WithCancel(client.Context())
 	return ls
 }
 
+// SetStatus selects the Status filter with value status (e.g. "IsUnplayed",
+// "" for all) before the screen is shown, as remembered for the library.
+func (ls *LibraryScreen) SetStatus(status string) {
+	for i, opt := range statusOptions {
+		if opt.Filter == status {
+			ls.filterBar.Filters[2].Selected = i
+			ls.savedStatus = i
+			ls.filter = ls.buildFilter()
+			return
+		}
+	}
+}
+
+// statusUnplayed is the statusOptions index toggled by U.
+const statusUnplayed = 1
+
+// toggleUnplayed flips the Status filter between unplayed and all.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) toggleUnplayed() {
+	pill := &ls.filterBar.Filters[2]
+	if pill.Selected == statusUnplayed {
+		pill.Selected = 0
+		ShowToast("Showing all items")
+	} else {
+		pill.Selected = statusUnplayed
+		ShowToast("Showing unwatched only")
+	}
+	ls.applyFilters()
+}
+
+// filtersActive reports whether the applied filters hide any items, so an
+// empty library can offer to clear them. Caller must hold ls.mu.
+func (ls *LibraryScreen) filtersActive() bool {
+	f := ls.filter
+	return len(f.Genres) > 0 || f.Status != "" || f.Letter != "" || len(f.Years) > 0 || f.Search != ""
+}
+
+// clearFilters resets every pill but Sort to "All", empties the search and
+// reloads. Caller must hold ls.mu.
+func (ls *LibraryScreen) clearFilters() {
+	for i := 1; i < len(ls.filterBar.Filters); i++ {
+		ls.filterBar.Filters[i].Selected = 0
+		ls.filterBar.Filters[i].Picked = nil
+	}
+	ls.filterBar.SetSearchText("")
+	ls.applyFilters()
+}
+
+// reportStatus passes a changed Status filter to OnStatusChanged.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) reportStatus() {
+	idx := ls.filterBar.Filters[2].Selected
+	if idx == ls.savedStatus || idx < 0 || idx >= len(statusOptions) {
+		return
+	}
+	ls.savedStatus = idx
+	if ls.OnStatusChanged != nil {
+		ls.OnStatusChanged(statusOptions[idx].Filter)
+	}
+}
+
 func (ls *LibraryScreen) Name() string { return "Library: " + ls.title }
 
+func (ls *LibraryScreen) EditText(edit func(ti *TextInput)) bool {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if ls.focusMode != focusFilterBar || !ls.filterBar.IsSearchFocused() || ls.contextMenu != nil {
+		return false
+	}
+	edit(&ls.filterBar.SearchInput)
+	return true
+}
+
 func (ls *LibraryScreen) OnEnter() {
 	if !ls.loaded && !ls.loading {
 		ls.loading = true
@@ -168,7 +271,7 @@ func (ls *LibraryScreen) OnEnter() {
 // detectAndLoad checks the collection type and sets item type filters before loading.
 func (ls *LibraryScreen) detectAndLoad() {
 	if ls.parentID != "" && len(ls.itemTypes) == 0 {
-		ct, err := ls.client.GetCollectionType(ls.parentID)
+		ct, err := ls.client.GetCollectionTypeContext(ls.loadCtx, ls.parentID)
 		if err == nil && ct == "tvshows" {
 			ls.mu.Lock()
 			ls.collectionType = ct
@@ -176,14 +279,55 @@ func (ls *LibraryScreen) detectAndLoad() {
 			ls.mu.Unlock()
 		}
 	}
+	if ls.parentID != "" {
+		ls.loadSortPreference()
+	}
 	go ls.loadGenres()
 	ls.loadData(0)
 }
 
-func (ls *LibraryScreen) OnExit() {}
+// loadSortPreference selects the sort saved in the library's display
+// preferences, as the web client remembers it. A sort JellyCouch doesn't
+// offer keeps the default.
+func (ls *LibraryScreen) loadSortPreference() {
+	prefs, err := ls.client.GetDisplayPreferencesContext(ls.loadCtx, ls.parentID)
+	if err != nil {
+		log.Printf("Failed to load display preferences: %v", err)
+		return
+	}
+	sortBy, _, _ := strings.Cut(prefs.SortBy, ",")
+	for i, opt := range sortOptions {
+		if opt.SortBy == sortBy && opt.SortOrder == prefs.SortOrder {
+			ls.mu.Lock()
+			ls.filterBar.Filters[0].Selected = i
+			ls.savedSort = i
+			ls.filter = ls.buildFilter()
+			ls.mu.Unlock()
+			return
+		}
+	}
+}
+
+// saveSortPreference stores a changed sort in the library's display
+// preferences in the background. Caller must hold ls.mu.
+func (ls *LibraryScreen) saveSortPreference() {
+	idx := ls.filterBar.Filters[0].Selected
+	if ls.parentID == "" || idx == ls.savedSort || idx < 0 || idx >= len(sortOptions) {
+		return
+	}
+	ls.savedSort = idx
+	prefs := jellyfin.DisplayPreferences{SortBy: sortOptions[idx].SortBy, SortOrder: sortOptions[idx].SortOrder}
+	go func() {
+		if err := ls.client.UpdateDisplayPreferences(ls.parentID, prefs); err != nil {
+			log.Printf("Failed to save display preferences: %v", err)
+		}
+	}()
+}
+
+func (ls *LibraryScreen) OnExit() { ls.cancelLoad() }
 
 func (ls *LibraryScreen) loadGenres() {
-	genres, err := ls.client.GetGenres(ls.parentID, ls.itemTypes)
+	genres, err := ls.client.GetGenresContext(ls.loadCtx, ls.parentID, ls.itemTypes)
 	if err != nil {
 		log.Printf("Failed to load genres: %v", err)
 		return
@@ -199,10 +343,8 @@ func (ls *LibraryScreen) loadGenres() {
 	genreOptions = append(genreOptions, genres...)
 	if len(ls.filterBar.Filters) > 1 {
 		ls.filterBar.Filters[1].Options = genreOptions
-		// Reset selection if it's out of range
-		if ls.filterBar.Filters[1].Selected >= len(genreOptions) {
-			ls.filterBar.Filters[1].Selected = 0
-		}
+		// Drop genres that are out of range
+		ls.filterBar.Filters[1].ClampPicked()
 	}
 	ls.mu.Unlock()
 }
@@ -217,11 +359,8 @@ func (ls *LibraryScreen) buildFilter() jellyfin.LibraryFilter {
 		f.SortOrder = sortOptions[sortIdx].SortOrder
 	}
 
-	// Genre
-	genreVal := ls.filterBar.Filters[1].Value()
-	if genreVal != "" && genreVal != "All" {
-		f.Genres = []string{genreVal}
-	}
+	// Genres; the server matches items with any of them
+	f.Genres = ls.filterBar.Filters[1].Values()
 
 	// Status
 	statusIdx := ls.filterBar.Filters[2].Selected
@@ -248,6 +387,9 @@ func (ls *LibraryScreen) buildFilter() jellyfin.LibraryFilter {
 }
 
 func (ls *LibraryScreen) applyFilters() {
+	ls.exitSelecting()
+	ls.saveSortPreference()
+	ls.reportStatus()
 	ls.filter = ls.buildFilter()
 	ls.appliedSearch = ls.filterBar.SearchInput.Text
 	ls.items = nil
@@ -267,7 +409,7 @@ func (ls *LibraryScreen) loadData(start int) {
 	filter := ls.filter
 	ls.mu.Unlock()
 
-	items, total, err := ls.client.GetFilteredItems(ls.parentID, start, 50, ls.itemTypes, filter)
+	items, total, err := ls.client.GetFilteredItemsContext(ls.loadCtx, ls.parentID, start, 50, ls.itemTypes, filter)
 	if err != nil {
 		log.Printf("Failed to load library items: %v", err)
 		ls.mu.Lock()
@@ -287,6 +429,7 @@ func (ls *LibraryScreen) loadData(start int) {
 	ls.gridItems = make([]GridItem, len(ls.items))
 	for i, item := range ls.items {
 		ls.gridItems[i] = GridItemFromMediaItem(item)
+		ls.gridItems[i].Selected = ls.selected[item.ID]
 	}
 	LoadGridItemImages(ls.client, ls.imgCache, &ls.gridItems, ls.items, &ls.mu)
 
@@ -310,6 +453,31 @@ func (ls *LibraryScreen) Update() (*ScreenTransition, error) {
 	ls.mu.Lock()
 	defer ls.mu.Unlock()
 
+	// Context menu captures all input while open
+	if ls.contextMenu != nil {
+		ls.contextMenu.Update()
+		if done, action, ok := ls.contextMenu.Done(); done {
+			item, target := ls.contextMenu.Item(), ls.contextMenu.Target()
+			ls.contextMenu = nil
+			if ok {
+				ls.runContextAction(item.ID, action, target)
+			}
+		}
+		return nil, nil
+	}
+
+	if ls.selecting {
+		return ls.updateSelecting()
+	}
+
+	// Genre checklist captures all input while open
+	if ls.filterBar.PickerOpen() {
+		if ls.filterBar.Update() {
+			ls.applyFilters()
+		}
+		return nil, nil
+	}
+
 	ls.ScrollState.HandleMouseWheel()
 
 	// Mouse click handling
@@ -317,17 +485,23 @@ func (ls *LibraryScreen) Update() (*ScreenTransition, error) {
 	if clicked && ls.errDisplay.HandleClick(mx, my, ls.loadError) {
 		return nil, nil
 	}
+	if clicked && ls.loaded && len(ls.gridItems) == 0 && ls.emptyState.HandleClick(mx, my) {
+		ls.clearFilters()
+		return nil, nil
+	}
 
 	// Filter bar mouse click
 	if clicked {
 		if idx, ok := ls.filterBar.HandleClick(mx, my); ok {
 			ls.focusMode = focusFilterBar
 			ls.filterBar.Active = true
+			if ls.filterBar.OpenPicker(idx) {
+				return nil, nil
+			}
 			if idx < len(ls.filterBar.Filters) {
 				ls.filterBar.FocusedIndex = idx
 				// Cycle pill value on click
-				pill := &ls.filterBar.Filters[idx]
-				pill.Selected = (pill.Selected + 1) % len(pill.Options)
+				ls.filterBar.Filters[idx].cycle(1)
 				ls.applyFilters()
 			} else {
 				ls.filterBar.FocusedIndex = idx
@@ -350,14 +524,18 @@ func (ls *LibraryScreen) Update() (*ScreenTransition, error) {
 		}
 	}
 
-	// Right-click: toggle watched state
+	// Right-click: open context menu for the item under the cursor
 	rmx, rmy, rclicked := MouseJustRightClicked()
-	if rclicked && ls.loaded {
+	if rclicked && PosterRightClick != RightClickNone && ls.loaded {
 		gridBase := ls.gridBaseY() - ls.ScrollY
 		if idx, ok := ls.grid.HandleClick(rmx, rmy, SectionPadding, gridBase); ok {
-			if idx < len(ls.items) {
-				ls.items[idx].Played = ToggleWatched(ls.client, ls.items[idx].ID, ls.items[idx].Played)
-				ls.gridItems[idx].Watched = ls.items[idx].Played
+			ls.focusMode = focusGrid
+			ls.filterBar.Active = false
+			ls.grid.Focused = idx
+			if PosterRightClick == RightClickMenu {
+				ls.openContextMenu(float64(rmx), float64(rmy))
+			} else if idx < len(ls.gridItems) {
+				ls.runContextAction(ls.gridItems[idx].ID, ActionToggleWatched, "")
 			}
 			return nil, nil
 		}
@@ -372,19 +550,19 @@ func (ls *LibraryScreen) Update() (*ScreenTransition, error) {
 
 func (ls *LibraryScreen) updateFilterBar() (*ScreenTransition, error) {
 	// Escape from filter bar → return to grid
-	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+	if KeyJustPressed(ebiten.KeyEscape) {
 		ls.focusMode = focusGrid
 		ls.filterBar.Active = false
 		return nil, nil
 	}
 
 	// Up from filter bar (non-search) → focus navbar
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) && !ls.filterBar.IsSearchFocused() {
+	if KeyJustPressed(ebiten.KeyArrowUp) && !ls.filterBar.IsSearchFocused() {
 		ls.filterBar.Active = false
 		return &ScreenTransition{Type: TransitionFocusNavBar}, nil
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) && !ls.filterBar.IsSearchFocused() {
+	if KeyJustPressed(ebiten.KeyArrowDown) && !ls.filterBar.IsSearchFocused() {
 		ls.focusMode = focusGrid
 		ls.filterBar.Active = false
 		return nil, nil
@@ -413,6 +591,12 @@ func (ls *LibraryScreen) updateGrid() (*ScreenTransition, error) {
 		return &ScreenTransition{Type: TransitionPop}, nil
 	}
 
+	// Letters typed on the grid jump to matching titles, so the letter
+	// shortcuts below take Ctrl (Cmd on macOS)
+	if ls.loaded && !ctrlPressed() && ls.typeAheadJump(ebiten.AppendInputChars(nil)) {
+		return nil, nil
+	}
+
 	// Shortcut keys
 	if inpututil.IsKeyJustPressed(ebiten.KeySlash) {
 		ls.focusMode = focusFilterBar
@@ -420,7 +604,7 @@ func (ls *LibraryScreen) updateGrid() (*ScreenTransition, error) {
 		ls.filterBar.FocusedIndex = len(ls.filterBar.Filters) // search input
 		return nil, nil
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyF) {
+	if shortcutJustPressed(ebiten.KeyF) {
 		ls.focusMode = focusFilterBar
 		ls.filterBar.Active = true
 		ls.filterBar.FocusedIndex = 0
@@ -428,6 +612,25 @@ func (ls *LibraryScreen) updateGrid() (*ScreenTransition, error) {
 	}
 
 	if !ls.loaded {
+		// OK retries a failed load; loading guards against a second request
+		if enter && ls.loadError != "" && !ls.loading {
+			ls.applyFilters()
+		}
+		return nil, nil
+	}
+
+	if shortcutJustPressed(ebiten.KeyM) {
+		ls.startSelecting()
+		return nil, nil
+	}
+	if shortcutJustPressed(ebiten.KeyU) {
+		ls.toggleUnplayed()
+		return nil, nil
+	}
+
+	if ContextMenuKeyPressed() {
+		x, y := ls.grid.ItemRect(ls.grid.Focused, SectionPadding, ls.gridBaseY()-ls.ScrollY)
+		ls.openContextMenu(x+ls.grid.PosterW/2, y+ls.grid.PosterH/3)
 		return nil, nil
 	}
 
@@ -457,7 +660,9 @@ func (ls *LibraryScreen) updateGrid() (*ScreenTransition, error) {
 
 	if enter {
 		idx := ls.grid.Focused
-		if idx < len(ls.items) && ls.OnItemSelected != nil {
+		if len(ls.gridItems) == 0 && ls.filtersActive() {
+			ls.clearFilters()
+		} else if idx < len(ls.items) && ls.OnItemSelected != nil {
 			ls.OnItemSelected(ls.items[idx])
 		}
 	}
@@ -465,15 +670,61 @@ func (ls *LibraryScreen) updateGrid() (*ScreenTransition, error) {
 	return nil, nil
 }
 
+// typeAheadJump adds typed characters to the type-ahead string and focuses
+// the next loaded item whose title starts with it. It reports whether the
+// characters were taken. Caller must hold ls.mu.
+func (ls *LibraryScreen) typeAheadJump(chars []rune) bool {
+	prefix, next := ls.typeAhead.add(chars)
+	if prefix == "" || len(ls.items) == 0 {
+		return prefix != ""
+	}
+	names := make([]string, len(ls.items))
+	for i, item := range ls.items {
+		names[i] = item.Name
+	}
+	if idx := typeAheadMatch(names, ls.grid.Focused, prefix, next); idx >= 0 {
+		ls.grid.Focused = idx
+		ls.ensureVisible()
+	}
+	return true
+}
+
+// openContextMenu opens the context menu for the focused grid item.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) openContextMenu(x, y float64) {
+	idx := ls.grid.Focused
+	if idx < 0 || idx >= len(ls.gridItems) {
+		return
+	}
+	ls.contextMenu = NewContextMenu(ls.gridItems[idx], x, y, ContextMenuOptions{
+		CanRequest: ls.OnRequest4K != nil,
+		CanRefresh: cachedIsAdmin(ls.client),
+		Playlists:  cachedPlaylists(ls.client),
+	})
+}
+
+// runContextAction applies a context menu action to the item with itemID.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) runContextAction(itemID string, action ContextAction, target string) {
+	for i := range ls.gridItems {
+		if ls.gridItems[i].ID != itemID {
+			continue
+		}
+		runContextAction(ls.client, &ls.gridItems[i], action, target, gridActionCallbacks{
+			OnItemSelected: ls.OnItemSelected,
+			OnPlay:         ls.OnPlay,
+			OnRequest4K:    ls.OnRequest4K,
+		})
+		if i < len(ls.items) {
+			ls.items[i].Played = ls.gridItems[i].Watched
+		}
+		return
+	}
+}
+
 func (ls *LibraryScreen) ensureVisible() {
-	row := ls.grid.FocusedRow()
-	rowH := float64(PosterHeight + PosterGap + FontSizeSmall + FontSizeCaption + 16)
-	visibleH := float64(ScreenHeight) - ls.gridBaseY()
-	targetY := float64(row)*rowH - visibleH/2 + rowH/2
-	if targetY < 0 {
-		targetY = 0
-	}
-	ls.TargetScrollY = targetY
+	rowH := ls.grid.RowHeight()
+	ls.CenterRow(ls.gridBaseY()+float64(ls.grid.FocusedRow())*rowH, rowH, ls.gridBaseY())
 }
 
 func (ls *LibraryScreen) Draw(dst *ebiten.Image) {
@@ -485,56 +736,77 @@ func (ls *LibraryScreen) Draw(dst *ebiten.Image) {
 	// Title bar (below navbar)
 	DrawText(dst, ls.title, SectionPadding, NavBarHeight+16, FontSizeTitle, ColorText)
 	if ls.total > 0 {
-		countStr := fmt.Sprintf("%d items", ls.total)
+		countStr := fmt.Sprintf("%s items", FormatCount(ls.total))
 		DrawText(dst, countStr, float64(ScreenWidth)-200, NavBarHeight+24, FontSizeSmall, ColorTextMuted)
 	}
 
 	// Filter bar
 	ls.filterBar.Draw(dst, SectionPadding, float64(NavBarHeight*2))
+	defer ls.filterBar.DrawPicker(dst) // above the grid, in every state
 
 	if ls.loadError != "" && !ls.loaded {
 		errX := float64(ScreenWidth)/2 - 300
 		errY := float64(ScreenHeight)/2 - 20
 		ls.errDisplay.Draw(dst, ls.loadError, errX, errY, FontSizeBody)
-		DrawTextCentered(dst, "Press Esc to go back", float64(ScreenWidth)/2, float64(ScreenHeight)/2+20,
+		DrawTextCentered(dst, T("common.press_enter_retry"), float64(ScreenWidth)/2, float64(ScreenHeight)/2+20,
 			FontSizeSmall, ColorTextMuted)
 		return
 	}
 
 	if !ls.loaded {
-		DrawTextCentered(dst, "Loading...", float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+		DrawTextCentered(dst, T("common.loading"), float64(ScreenWidth)/2, float64(ScreenHeight)/2,
 			FontSizeHeading, ColorTextSecondary)
 		return
 	}
 
 	if len(ls.gridItems) == 0 {
-		DrawTextCentered(dst, "No items found", float64(ScreenWidth)/2, float64(ScreenHeight)/2,
-			FontSizeHeading, ColorTextSecondary)
-
+		action := ""
+		if ls.filtersActive() {
+			action = T("library.clear_filters")
+		}
+		ls.emptyState.Draw(dst, T("library.no_items"), action, ls.focusMode == focusGrid,
+			float64(ScreenWidth)/2, float64(ScreenHeight)/2)
 		return
 	}
 
 	// Draw grid
 	baseY := ls.gridBaseY() - ls.ScrollY
-	for i, item := range ls.gridItems {
-		x, y := ls.grid.ItemRect(i, SectionPadding, baseY)
-
-		// Skip offscreen
-		if y+PosterHeight < 0 || y > float64(ScreenHeight) {
-			continue
-		}
-
-		isFocused := ls.focusMode == focusGrid && i == ls.grid.Focused
-		drawPosterItem(dst, item, x, y, isFocused)
+	focused := -1
+	if ls.focusMode == focusGrid {
+		focused = ls.grid.Focused
 	}
+	ls.grid.DrawItems(dst, ls.gridItems, SectionPadding, baseY, focused)
+	bottomPad := 40.0 // room for the loading-more line
+	if ls.selecting {
+		bottomPad += selectBarH
+	}
+	ls.SetGridRows((len(ls.gridItems)+ls.grid.Cols-1)/ls.grid.Cols, ls.grid.RowHeight(), ls.gridBaseY(), bottomPad)
 
 	// Loading more indicator at bottom
 	if ls.loadingMore {
 		totalRows := (len(ls.items) + ls.grid.Cols - 1) / ls.grid.Cols
-		bottomY := baseY + float64(totalRows)*(GridRowHeight) + 20
-		DrawTextCentered(dst, "Loading more...", float64(ScreenWidth)/2, bottomY,
+		bottomY := baseY + float64(totalRows)*ls.grid.RowHeight() + 20
+		DrawTextCentered(dst, T("common.loading_more"), float64(ScreenWidth)/2, bottomY,
 			FontSizeBody, ColorTextSecondary)
 	}
 
+	// Position in the whole library, not just the pages loaded so far
+	rows := (max(ls.total, len(ls.gridItems)) + ls.grid.Cols - 1) / ls.grid.Cols
+	row := int(ls.ScrollY / ls.grid.RowHeight())
+	if ls.focusMode == focusGrid {
+		row = ls.grid.FocusedRow()
+	}
+	ls.DrawScrollIndicator(dst, ls.gridBaseY(), ls.grid.RowHeight(), row, rows)
+
+	ls.typeAhead.draw(dst)
+	if ls.selecting {
+		ls.drawSelectBar(dst)
+	}
+	if ls.contextMenu != nil {
+		ls.contextMenu.Draw(dst)
+	}
+	if ls.playlistMenu != nil {
+		ls.playlistMenu.Draw(dst)
+	}
 }
 
@@ -0,0 +1,275 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Batch actions offered by the library's multi-select bar
+const (
+	batchMarkWatched = iota
+	batchMarkUnwatched
+	batchFavorite
+	batchAddToPlaylist
+	batchDone
+)
+
+var batchLabels = []string{"Mark Watched", "Mark Unwatched", "Add to Favorites", "Add to Playlist", "Done"}
+
+const (
+	selectBarH    = 64.0
+	selectBarBtnH = 40.0
+)
+
+// startSelecting enters multi-select mode with nothing picked.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) startSelecting() {
+	ls.selecting = true
+	ls.selected = make(map[string]bool)
+	ls.barFocused = false
+	ls.barIndex = 0
+}
+
+// exitSelecting leaves multi-select mode and drops the selection.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) exitSelecting() {
+	ls.selecting = false
+	ls.selected = nil
+	ls.barFocused = false
+	ls.playlistMenu = nil
+	for i := range ls.gridItems {
+		ls.gridItems[i].Selected = false
+	}
+}
+
+// toggleSelected picks or drops the grid item at idx. Caller must hold ls.mu.
+func (ls *LibraryScreen) toggleSelected(idx int) {
+	if idx < 0 || idx >= len(ls.gridItems) {
+		return
+	}
+	id := ls.gridItems[idx].ID
+	if ls.selected[id] {
+		delete(ls.selected, id)
+	} else {
+		ls.selected[id] = true
+	}
+	ls.gridItems[idx].Selected = ls.selected[id]
+}
+
+// selectedIDs returns the picked item IDs in grid order. Caller must hold ls.mu.
+func (ls *LibraryScreen) selectedIDs() []string {
+	var ids []string
+	for _, item := range ls.items {
+		if ls.selected[item.ID] {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+// updateSelecting handles input in multi-select mode: Space or OK picks the
+// focused poster, Down past the last row (or the menu key) reaches the action
+// bar, and Back or M leaves the mode. Caller must hold ls.mu.
+func (ls *LibraryScreen) updateSelecting() (*ScreenTransition, error) {
+	if ls.playlistMenu != nil {
+		ls.playlistMenu.Update()
+		if done, _, ok := ls.playlistMenu.Done(); done {
+			target := ls.playlistMenu.Target()
+			ls.playlistMenu = nil
+			if ok {
+				ls.addSelectedToPlaylist(target)
+			}
+		}
+		return nil, nil
+	}
+
+	ls.ScrollState.HandleMouseWheel()
+
+	if mx, my, clicked := MouseJustClicked(); clicked {
+		for i, r := range ls.barRects {
+			if r.Hit(mx, my) {
+				ls.barIndex = i
+				ls.runBatchAction(i)
+				return nil, nil
+			}
+		}
+		gridBase := ls.gridBaseY() - ls.ScrollY
+		if idx, ok := ls.grid.HandleClick(mx, my, SectionPadding, gridBase); ok {
+			ls.barFocused = false
+			ls.grid.Focused = idx
+			ls.toggleSelected(idx)
+		}
+		return nil, nil
+	}
+
+	dir, enter, back := InputState()
+	if back || shortcutJustPressed(ebiten.KeyM) {
+		ls.exitSelecting()
+		return nil, nil
+	}
+	if ContextMenuKeyPressed() {
+		ls.barFocused = !ls.barFocused
+		return nil, nil
+	}
+
+	if ls.barFocused {
+		switch dir {
+		case DirLeft:
+			if ls.barIndex > 0 {
+				ls.barIndex--
+			}
+		case DirRight:
+			if ls.barIndex < len(batchLabels)-1 {
+				ls.barIndex++
+			}
+		case DirUp:
+			ls.barFocused = false
+		}
+		if enter {
+			ls.runBatchAction(ls.barIndex)
+		}
+		return nil, nil
+	}
+
+	if dir == DirDown && ls.grid.FocusedRow() == (len(ls.gridItems)-1)/ls.grid.Cols {
+		ls.barFocused = true
+		return nil, nil
+	}
+	if dir != DirNone && ls.grid.Update(dir) {
+		ls.ensureVisible()
+	}
+	if enter || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		ls.toggleSelected(ls.grid.Focused)
+	}
+
+	if !ls.loadingMore && len(ls.items) < ls.total &&
+		(len(ls.items)+ls.grid.Cols-1)/ls.grid.Cols-ls.grid.FocusedRow() <= 2 {
+		ls.loadingMore = true
+		go ls.loadMore()
+	}
+	return nil, nil
+}
+
+// runBatchAction applies bar action i to the picked items. The grid updates
+// at once and the requests run in the background. Caller must hold ls.mu.
+func (ls *LibraryScreen) runBatchAction(i int) {
+	if i == batchDone {
+		ls.exitSelecting()
+		return
+	}
+	ids := ls.selectedIDs()
+	if len(ids) == 0 {
+		ShowToast("Select items with Space or OK first")
+		return
+	}
+
+	switch i {
+	case batchMarkWatched, batchMarkUnwatched:
+		played := i == batchMarkWatched
+		ls.updateSelected(func(gi *GridItem) { gi.Watched = played })
+		client := ls.client
+		go func() {
+			batch := client.MarkPlayedBatch
+			if !played {
+				batch = client.MarkUnplayedBatch
+			}
+			reportBatch(batch(ids), len(ids), "Marked %d items")
+		}()
+	case batchFavorite:
+		ls.updateSelected(func(gi *GridItem) { gi.Favorite = true })
+		client := ls.client
+		go func() {
+			reportBatch(client.SetFavoriteBatch(ids, true), len(ids), "Added %d items to favorites")
+		}()
+	case batchAddToPlaylist:
+		x, y := float64(SectionPadding), float64(ScreenHeight)-selectBarH
+		if i < len(ls.barRects) {
+			x, y = ls.barRects[i].X, ls.barRects[i].Y
+		}
+		ls.playlistMenu = NewPlaylistMenu(cachedPlaylists(ls.client), x, y)
+		if ls.playlistMenu == nil {
+			ShowToast("No playlists yet")
+		}
+		return
+	}
+	ls.exitSelecting()
+}
+
+// addSelectedToPlaylist appends the picked items to a playlist in one
+// request. Caller must hold ls.mu.
+func (ls *LibraryScreen) addSelectedToPlaylist(playlistID string) {
+	ids := ls.selectedIDs()
+	if playlistID == "" || len(ids) == 0 {
+		return
+	}
+	client := ls.client
+	go func() {
+		reportBatch(client.AddToPlaylist(playlistID, ids...), len(ids), "Added %d items to the playlist")
+	}()
+	ls.exitSelecting()
+}
+
+// updateSelected applies fn to every picked grid item and copies the watched
+// and favorite state back to the item. Caller must hold ls.mu.
+func (ls *LibraryScreen) updateSelected(fn func(gi *GridItem)) {
+	for i := range ls.gridItems {
+		if !ls.selected[ls.gridItems[i].ID] {
+			continue
+		}
+		fn(&ls.gridItems[i])
+		if i < len(ls.items) {
+			ls.items[i].Played = ls.gridItems[i].Watched
+			if ls.items[i].UserData != nil {
+				ls.items[i].UserData.IsFavorite = ls.gridItems[i].Favorite
+			}
+		}
+	}
+}
+
+// reportBatch shows the outcome of a batch request as a toast.
+func reportBatch(err error, n int, format string) {
+	if err != nil {
+		log.Printf("Batch action failed: %v", err)
+		ShowToast("Some items could not be updated")
+		return
+	}
+	ShowToast(fmt.Sprintf(format, n))
+}
+
+// drawSelectBar draws the multi-select action bar along the bottom.
+// Caller must hold ls.mu.
+func (ls *LibraryScreen) drawSelectBar(dst *ebiten.Image) {
+	y := float64(ScreenHeight) - selectBarH
+	vector.DrawFilledRect(dst, 0, float32(y), float32(ScreenWidth), selectBarH, ColorSurface, false)
+	vector.DrawFilledRect(dst, 0, float32(y), float32(ScreenWidth), 2, ColorPrimary, false)
+
+	count := fmt.Sprintf("%s selected", FormatCount(len(ls.selected)))
+	DrawText(dst, count, SectionPadding, y+(selectBarH-FontSizeBody)/2, FontSizeBody, ColorText)
+
+	// Buttons, right-aligned
+	widths := make([]float64, len(batchLabels))
+	total := 0.0
+	for i, label := range batchLabels {
+		tw, _ := MeasureText(label, FontSizeBody)
+		widths[i] = tw + 40
+		total += widths[i] + 12
+	}
+	bx := float64(ScreenWidth) - SectionPadding - total + 12
+	by := y + (selectBarH-selectBarBtnH)/2
+	ls.barRects = ls.barRects[:0]
+	for i, label := range batchLabels {
+		w := widths[i]
+		ls.barRects = append(ls.barRects, ButtonRect{X: bx, Y: by, W: w, H: selectBarBtnH})
+		bg, fg := ColorSurfaceHover, ColorTextSecondary
+		if ls.barFocused && i == ls.barIndex {
+			bg, fg = ColorPrimary, ColorText
+		}
+		vector.DrawFilledRect(dst, float32(bx), float32(by), float32(w), selectBarBtnH, bg, false)
+		DrawTextCentered(dst, label, bx+w/2, by+selectBarBtnH/2, FontSizeBody, fg)
+		bx += w + 12
+	}
+}
@@ -37,7 +37,8 @@ func NewLoginScreen(serverURL string, onLogin func(screen *LoginScreen, server,
 		NewTextInput(""),
 		NewTextInput(""),
 	}
-	ls.labels = [3]string{"Server URL", "Username", "Password"}
+	ls.inputs[2].Secret = true
+	ls.labels = [3]string{T("login.server_url"), T("login.username"), T("login.password")}
 	return ls
 }
 
@@ -45,6 +46,14 @@ func (ls *LoginScreen) Name() string { return "Login" }
 func (ls *LoginScreen) OnEnter()     {}
 func (ls *LoginScreen) OnExit()      {}
 
+func (ls *LoginScreen) EditText(edit func(ti *TextInput)) bool {
+	if ls.Busy || ls.fieldIndex >= 3 {
+		return false
+	}
+	edit(&ls.inputs[ls.fieldIndex])
+	return true
+}
+
 func (ls *LoginScreen) Update() (*ScreenTransition, error) {
 	if ls.Busy {
 		return nil, nil
@@ -78,10 +87,10 @@ func (ls *LoginScreen) Update() (*ScreenTransition, error) {
 			ls.fieldIndex = (ls.fieldIndex + 1) % 4
 		}
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+	if KeyJustPressed(ebiten.KeyArrowDown) {
 		ls.fieldIndex = (ls.fieldIndex + 1) % 4
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+	if KeyJustPressed(ebiten.KeyArrowUp) {
 		ls.fieldIndex--
 		if ls.fieldIndex < 0 {
 			ls.fieldIndex = 3
@@ -89,7 +98,7 @@ func (ls *LoginScreen) Update() (*ScreenTransition, error) {
 	}
 
 	// Submit — Enter from any field or the button
-	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+	if KeyJustPressed(ebiten.KeyEnter) {
 		ls.submit()
 	}
 
@@ -102,12 +111,12 @@ func (ls *LoginScreen) submit() {
 	pass := ls.Password
 
 	if server == "" {
-		ls.Error = "Server URL is required"
+		ls.Error = T("login.server_required")
 		ls.fieldIndex = 0
 		return
 	}
 	if user == "" {
-		ls.Error = "Username is required"
+		ls.Error = T("login.username_required")
 		ls.fieldIndex = 1
 		return
 	}
@@ -153,7 +162,7 @@ func (ls *LoginScreen) Draw(dst *ebiten.Image) {
 
 	// Title
 	DrawTextCentered(dst, "JellyCouch", cx, cy-80, FontSizeTitle+8, ColorPrimary)
-	DrawTextCentered(dst, "Connect to your Jellyfin server", cx, cy-40, FontSizeBody, ColorTextSecondary)
+	DrawTextCentered(dst, T("login.subtitle"), cx, cy-40, FontSizeBody, ColorTextSecondary)
 
 	// Fields
 	fieldW := float32(400)
@@ -199,6 +208,9 @@ func (ls *LoginScreen) Draw(dst *ebiten.Image) {
 		if ls.inputs[i].Text == "" && i != ls.fieldIndex {
 			DrawText(dst, ls.placeholders()[i], float64(fx+10), float64(fy+12), FontSizeBody, ColorTextMuted)
 		} else {
+			if i == ls.fieldIndex && i != 2 {
+				ls.inputs[i].DrawSelection(dst, float64(fx+10), float64(fy+12), FontSizeBody)
+			}
 			DrawText(dst, value, float64(fx+10), float64(fy+12), FontSizeBody, ColorText)
 		}
 	}
@@ -221,9 +233,9 @@ func (ls *LoginScreen) Draw(dst *ebiten.Image) {
 		vector.StrokeRect(dst, bx, btnY, btnW, btnH, 2, ColorFocusBorder, false)
 	}
 
-	btnLabel := "Connect"
+	btnLabel := T("login.connect")
 	if ls.Busy {
-		btnLabel = "Connecting..."
+		btnLabel = T("login.connecting")
 	}
 	DrawTextCentered(dst, btnLabel, cx, float64(btnY+btnH/2), FontSizeBody, ColorText)
 
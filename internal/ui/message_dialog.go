@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	messageDialogW    = 820.0
+	messageDialogPad  = 32.0
+	messageDialogBtnW = 120.0
+	messageDialogBtnH = 40.0
+)
+
+// MessageDialog is a modal notice drawn above every screen, for failures the
+// user has to see (e.g. playback could not start). Enter, Back or a click on
+// OK closes it.
+type MessageDialog struct {
+	Title   string
+	Message string // explanation and guidance, wrapped
+	Detail  string // the underlying error, shown with a Copy button
+
+	okRect     ButtonRect
+	errDisplay ErrorDisplay
+}
+
+// Update handles input and reports whether the dialog should close.
+func (md *MessageDialog) Update() bool {
+	_, enter, back := InputState()
+	if enter || back {
+		return true
+	}
+	mx, my, clicked := MouseJustClicked()
+	if clicked {
+		if md.errDisplay.HandleClick(mx, my, md.Detail) {
+			return false
+		}
+		return md.okRect.Hit(mx, my)
+	}
+	return false
+}
+
+func (md *MessageDialog) Draw(dst *ebiten.Image) {
+	vector.DrawFilledRect(dst, 0, 0, ScreenWidth, ScreenHeight, ColorOverlay, false)
+
+	textW := messageDialogW - messageDialogPad*2
+	h := messageDialogPad + FontSizeHeading + 20 +
+		MeasureTextWrapped(md.Message, textW, FontSizeBody) + 16
+	if md.Detail != "" {
+		h += FontSizeSmall + 24
+	}
+	h += messageDialogBtnH + messageDialogPad
+
+	x := (float64(ScreenWidth) - messageDialogW) / 2
+	y := (float64(ScreenHeight) - h) / 2
+	vector.DrawFilledRect(dst, float32(x), float32(y), messageDialogW, float32(h), ColorBackground, false)
+	vector.StrokeRect(dst, float32(x), float32(y), messageDialogW, float32(h), 2, ColorError, false)
+
+	tx := x + messageDialogPad
+	ty := y + messageDialogPad
+	DrawText(dst, md.Title, tx, ty, FontSizeHeading, ColorText)
+	ty += FontSizeHeading + 20
+	ty += DrawTextWrapped(dst, md.Message, tx, ty, textW, FontSizeBody, ColorTextSecondary) + 16
+	if md.Detail != "" {
+		detail := truncateText(md.Detail, textW-70, FontSizeSmall)
+		md.errDisplay.Draw(dst, detail, tx, ty, FontSizeSmall)
+		ty += FontSizeSmall + 24
+	}
+
+	bx := x + messageDialogW - messageDialogPad - messageDialogBtnW
+	md.okRect = ButtonRect{X: bx, Y: ty, W: messageDialogBtnW, H: messageDialogBtnH}
+	vector.DrawFilledRect(dst, float32(bx), float32(ty), messageDialogBtnW, messageDialogBtnH, ColorPrimary, false)
+	DrawTextCentered(dst, T("common.ok"), bx+messageDialogBtnW/2, ty+messageDialogBtnH/2, FontSizeBody, ColorBackground)
+}
@@ -24,6 +24,18 @@ func IsModifierPressed() bool {
 		ebiten.IsKeyPressed(ebiten.KeyMeta)
 }
 
+// ctrlPressed reports whether Ctrl, or Cmd on macOS, is held.
+func ctrlPressed() bool {
+	return ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+}
+
+// shortcutJustPressed reports whether key was pressed this frame with Ctrl
+// (or Cmd) held. Letter shortcuts on grids take the modifier, leaving bare
+// letters to type-ahead.
+func shortcutJustPressed(key ebiten.Key) bool {
+	return inpututil.IsKeyJustPressed(key) && ctrlPressed()
+}
+
 // InputState returns the current navigation direction and action keys pressed this frame.
 func InputState() (dir Direction, enter, back bool) {
 	if inputRepeating(ebiten.KeyArrowUp) {
@@ -34,15 +46,33 @@ func InputState() (dir Direction, enter, back bool) {
 		dir = DirLeft
 	} else if inputRepeating(ebiten.KeyArrowRight) {
 		dir = DirRight
+	} else {
+		dir = remoteDirection()
 	}
-	enter = inpututil.IsKeyJustPressed(ebiten.KeyEnter) && !IsModifierPressed()
-	back = inpututil.IsKeyJustPressed(ebiten.KeyEscape) ||
+	enter = KeyJustPressed(ebiten.KeyEnter) && !IsModifierPressed()
+	back = KeyJustPressed(ebiten.KeyEscape) ||
 		inpututil.IsKeyJustPressed(ebiten.KeyBackspace) ||
 		inpututil.IsMouseButtonJustPressed(ebiten.MouseButton3) ||
 		EvdevBackJustPressed()
 	return
 }
 
+// remoteDirection returns the remote arrow pressed this frame, if any.
+// Held remote buttons repeat through the device's own autorepeat.
+func remoteDirection() Direction {
+	switch {
+	case RemoteJustPressed(RemoteUp):
+		return DirUp
+	case RemoteJustPressed(RemoteDown):
+		return DirDown
+	case RemoteJustPressed(RemoteLeft):
+		return DirLeft
+	case RemoteJustPressed(RemoteRight):
+		return DirRight
+	}
+	return DirNone
+}
+
 // UpdateInputState must be called at the end of each Update() to track key state.
 func UpdateInputState() {
 	// Update per-key hold frames
@@ -77,6 +107,41 @@ func inputRepeating(key ebiten.Key) bool {
 	return false
 }
 
+// sectionDigitKeys are the keys that jump to sections 1–9.
+var sectionDigitKeys = [][2]ebiten.Key{
+	{ebiten.KeyDigit1, ebiten.KeyNumpad1},
+	{ebiten.KeyDigit2, ebiten.KeyNumpad2},
+	{ebiten.KeyDigit3, ebiten.KeyNumpad3},
+	{ebiten.KeyDigit4, ebiten.KeyNumpad4},
+	{ebiten.KeyDigit5, ebiten.KeyNumpad5},
+	{ebiten.KeyDigit6, ebiten.KeyNumpad6},
+	{ebiten.KeyDigit7, ebiten.KeyNumpad7},
+	{ebiten.KeyDigit8, ebiten.KeyNumpad8},
+	{ebiten.KeyDigit9, ebiten.KeyNumpad9},
+}
+
+// sectionJump handles the section shortcuts of screens made of poster rows:
+// 1–9 focus that section, Page Up/Down move one section. It returns the
+// section to focus, or ok=false if no shortcut moved the focus.
+func sectionJump(current, count int) (target int, ok bool) {
+	target = current
+	for i, keys := range sectionDigitKeys {
+		if inpututil.IsKeyJustPressed(keys[0]) || inpututil.IsKeyJustPressed(keys[1]) {
+			target = i
+		}
+	}
+	if inputRepeating(ebiten.KeyPageUp) {
+		target--
+	}
+	if inputRepeating(ebiten.KeyPageDown) {
+		target++
+	}
+	if target < 0 || target >= count {
+		return current, false
+	}
+	return target, target != current
+}
+
 // MouseJustClicked returns the cursor position and whether the left mouse button was just clicked.
 func MouseJustClicked() (x, y int, clicked bool) {
 	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
@@ -101,27 +166,56 @@ func PointInRect(px, py int, rx, ry, rw, rh float64) bool {
 		float64(py) >= ry && float64(py) <= ry+rh
 }
 
+// PointInHitRect is PointInRect grown by HitPadding on every side. Use it for
+// buttons and posters; adjacent targets should be tested in drawing order.
+func PointInHitRect(px, py int, rx, ry, rw, rh float64) bool {
+	return PointInRect(px, py, rx-HitPadding, ry-HitPadding, rw+HitPadding*2, rh+HitPadding*2)
+}
+
 // MouseWheelDelta returns the mouse wheel scroll delta.
 func MouseWheelDelta() (dx, dy float64) {
 	return ebiten.Wheel()
 }
 
+// GridColumns forces the column count of the library and search poster
+// grids (ui.columns); 0 fits as many default-size posters as the width allows.
+var GridColumns = 0
+
 // FocusGrid handles 2D grid navigation.
 type FocusGrid struct {
 	Cols    int
 	Total   int
 	Focused int
-	ScrollY float64
-	targetScrollY float64
+
+	// Poster size of each cell
+	PosterW, PosterH float64
+
+	zoom focusZoomState
 }
 
 func NewFocusGrid(cols, total int) *FocusGrid {
 	return &FocusGrid{
-		Cols:  cols,
-		Total: total,
+		Cols:    cols,
+		Total:   total,
+		PosterW: PosterWidth,
+		PosterH: PosterHeight,
 	}
 }
 
+// NewPosterFocusGrid creates a full-width poster grid. An explicit
+// GridColumns takes precedence and the posters are scaled to fill the width
+// between the columns; otherwise the default poster size sets the columns.
+func NewPosterFocusGrid(total int) *FocusGrid {
+	avail := float64(ScreenWidth - SectionPadding*2)
+	if GridColumns <= 0 {
+		return NewFocusGrid(int(avail/(PosterWidth+PosterGap)), total)
+	}
+	fg := NewFocusGrid(GridColumns, total)
+	fg.PosterW = (avail - float64(GridColumns-1)*PosterGap) / float64(GridColumns)
+	fg.PosterH = fg.PosterW * PosterHeight / PosterWidth
+	return fg
+}
+
 func (fg *FocusGrid) Update(dir Direction) bool {
 	if fg.Total == 0 {
 		return false
@@ -175,16 +269,45 @@ func (fg *FocusGrid) SetTotal(total int) {
 func (fg *FocusGrid) ItemRect(i int, baseX, baseY float64) (x, y float64) {
 	col := i % fg.Cols
 	row := i / fg.Cols
-	x = baseX + float64(col)*(PosterWidth+PosterGap)
-	y = baseY + float64(row)*GridRowHeight
+	x = baseX + float64(col)*(fg.PosterW+PosterGap)
+	y = baseY + float64(row)*fg.RowHeight()
 	return
 }
 
+// DrawItems draws the posters of the grid starting at (baseX, baseY),
+// skipping those off screen. The focused one (-1 for none) is drawn last so
+// its focus zoom overlaps its neighbours.
+func (fg *FocusGrid) DrawItems(dst *ebiten.Image, items []GridItem, baseX, baseY float64, focused int) {
+	for i, item := range items {
+		x, y := fg.ItemRect(i, baseX, baseY)
+		if i == focused || y+fg.PosterH < 0 || y > float64(ScreenHeight) {
+			continue
+		}
+		drawPosterItemSized(dst, item, x, y, fg.PosterW, fg.PosterH, false)
+	}
+	if focused < 0 || focused >= len(items) {
+		fg.zoom.reset()
+		return
+	}
+	x, y := fg.ItemRect(focused, baseX, baseY)
+	drawPosterItemZoomed(dst, items[focused], x, y, fg.PosterW, fg.PosterH, true, fg.zoom.step(focused))
+}
+
+// RowHeight is the height of one grid row: poster, gap and labels.
+func (fg *FocusGrid) RowHeight() float64 {
+	return fg.PosterH + GridRowHeight - PosterHeight
+}
+
+// ItemHeight is a cell's poster plus its title and subtitle labels.
+func (fg *FocusGrid) ItemHeight() float64 {
+	return fg.PosterH + PosterItemHeight - PosterHeight
+}
+
 // HandleClick checks if (mx, my) hits any grid item and returns its index.
 func (fg *FocusGrid) HandleClick(mx, my int, baseX, baseY float64) (index int, ok bool) {
 	for i := 0; i < fg.Total; i++ {
 		x, y := fg.ItemRect(i, baseX, baseY)
-		if PointInRect(mx, my, x, y, PosterWidth, PosterHeight) {
+		if PointInHitRect(mx, my, x, y, fg.PosterW, fg.ItemHeight()) {
 			return i, true
 		}
 	}
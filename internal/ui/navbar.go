@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
@@ -40,6 +39,15 @@ func NewNavBar() *NavBar {
 	}
 }
 
+// EditText gives the on-screen keyboard the search bar while it has focus.
+func (nb *NavBar) EditText(edit func(ti *TextInput)) bool {
+	if !nb.Active || nb.focusSection != 1 {
+		return false
+	}
+	edit(&nb.input)
+	return true
+}
+
 // FocusFromBelow activates keyboard focus on the navbar (called when screen hands focus up).
 func (nb *NavBar) FocusFromBelow() {
 	nb.Active = true
@@ -53,11 +61,11 @@ func (nb *NavBar) Update() NavBarAction {
 	}
 
 	// Down or Escape returns focus to the screen
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+	if KeyJustPressed(ebiten.KeyArrowDown) {
 		nb.Active = false
 		return NavBarActionDefocus
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+	if KeyJustPressed(ebiten.KeyEscape) {
 		nb.Active = false
 		return NavBarActionDefocus
 	}
@@ -66,7 +74,7 @@ func (nb *NavBar) Update() NavBarAction {
 	case 0: // Home + Library buttons (index 0 = Home, 1+ = library views)
 		sectionLen := 1 + len(nb.LibraryViews) // Home + libraries
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if KeyJustPressed(ebiten.KeyEnter) {
 			if nb.libNavIndex == 0 {
 				if nb.OnNavigate != nil {
 					nb.OnNavigate("home", "", "")
@@ -81,14 +89,14 @@ func (nb *NavBar) Update() NavBarAction {
 			return NavBarActionDefocus
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		if KeyJustPressed(ebiten.KeyArrowRight) {
 			if nb.libNavIndex < sectionLen-1 {
 				nb.libNavIndex++
 			} else {
 				nb.focusSection = 1
 			}
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		if KeyJustPressed(ebiten.KeyArrowLeft) {
 			if nb.libNavIndex > 0 {
 				nb.libNavIndex--
 			}
@@ -97,7 +105,7 @@ func (nb *NavBar) Update() NavBarAction {
 	case 1: // Search bar
 		nb.input.Update()
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) && nb.input.Text != "" {
+		if KeyJustPressed(ebiten.KeyEnter) && nb.input.Text != "" {
 			query := nb.input.Text
 			nb.input.Clear()
 			if nb.OnSearch != nil {
@@ -108,13 +116,13 @@ func (nb *NavBar) Update() NavBarAction {
 		}
 
 		// Left at start → Home + library buttons
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) && nb.input.CursorAtStart() {
+		if KeyJustPressed(ebiten.KeyArrowLeft) && nb.input.CursorAtStart() {
 			nb.libNavIndex = len(nb.LibraryViews) // last library button, or Home if no libraries
 			nb.focusSection = 0
 		}
 
 		// Right at end → nav buttons
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) && nb.input.CursorAtEnd() {
+		if KeyJustPressed(ebiten.KeyArrowRight) && nb.input.CursorAtEnd() {
 			hasDiscovery := nb.JellyseerrEnabled != nil && nb.JellyseerrEnabled()
 			if hasDiscovery {
 				nb.navBtnIndex = 0
@@ -127,7 +135,7 @@ func (nb *NavBar) Update() NavBarAction {
 	case 2: // Nav buttons (discovery/settings)
 		hasDiscovery := nb.JellyseerrEnabled != nil && nb.JellyseerrEnabled()
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if KeyJustPressed(ebiten.KeyEnter) {
 			if nb.navBtnIndex == 0 && hasDiscovery {
 				if nb.OnNavigate != nil {
 					nb.OnNavigate("discovery", "", "")
@@ -141,13 +149,13 @@ func (nb *NavBar) Update() NavBarAction {
 			return NavBarActionDefocus
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		if KeyJustPressed(ebiten.KeyArrowRight) {
 			if nb.navBtnIndex == 0 && hasDiscovery {
 				nb.navBtnIndex = 1
 			}
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		if KeyJustPressed(ebiten.KeyArrowLeft) {
 			if nb.navBtnIndex == 1 && hasDiscovery {
 				nb.navBtnIndex = 0
 			} else {
@@ -159,25 +167,67 @@ func (nb *NavBar) Update() NavBarAction {
 	return NavBarActionNone
 }
 
+// Navbar button geometry, shared by Draw and HandleClick.
+const (
+	navBtnY       = 12.0
+	navBtnH       = 38.0
+	navBtnGap     = 10.0
+	navBtnTextPad = 28.0 // total horizontal padding around a button label
+	navHomeBtnX   = 230.0
+	navSearchW    = 400.0
+	navSettingsW  = 100.0 // minimum; translated labels may widen it
+	navDiscoverW  = 110.0 // minimum; translated labels may widen it
+	navIconBtnPad = 60.0  // label padding for buttons with a leading icon
+)
+
+// navBarLayout holds the rects of every navbar element for the current frame.
+type navBarLayout struct {
+	title     ButtonRect
+	home      ButtonRect
+	libs      []ButtonRect // parallel to LibraryViews
+	search    ButtonRect
+	discovery ButtonRect // zero W when Jellyseerr is not configured
+	settings  ButtonRect
+}
+
+func (nb *NavBar) layout() navBarLayout {
+	var l navBarLayout
+
+	tw, _ := MeasureText("JellyCouch", FontSizeTitle)
+	l.title = ButtonRect{X: SectionPadding, Y: navBtnY, W: tw, H: navBtnH}
+
+	tw, _ = MeasureText(T("nav.home"), FontSizeBody)
+	l.home = ButtonRect{X: navHomeBtnX, Y: navBtnY, W: tw + navBtnTextPad, H: navBtnH}
+
+	x := l.home.X + l.home.W + navBtnGap
+	l.libs = make([]ButtonRect, len(nb.LibraryViews))
+	for i, view := range nb.LibraryViews {
+		tw, _ := MeasureText(view.Name, FontSizeBody)
+		l.libs[i] = ButtonRect{X: x, Y: navBtnY, W: tw + navBtnTextPad, H: navBtnH}
+		x += l.libs[i].W + navBtnGap
+	}
+
+	l.search = ButtonRect{X: float64(ScreenWidth)/2 - navSearchW/2, Y: navBtnY, W: navSearchW, H: navBtnH}
+	tw, _ = MeasureText(T("nav.settings"), FontSizeBody)
+	settingsW := max(navSettingsW, tw+navIconBtnPad)
+	l.settings = ButtonRect{X: float64(ScreenWidth) - SectionPadding - settingsW, Y: navBtnY, W: settingsW, H: navBtnH}
+	if nb.JellyseerrEnabled != nil && nb.JellyseerrEnabled() {
+		tw, _ = MeasureText(T("nav.discovery"), FontSizeBody)
+		discoverW := max(navDiscoverW, tw+navIconBtnPad)
+		l.discovery = ButtonRect{X: l.settings.X - navBtnGap - discoverW, Y: navBtnY, W: discoverW, H: navBtnH}
+	}
+	return l
+}
+
 // HandleClick checks if (mx, my) hits a navbar element and triggers navigation. Returns true if consumed.
 func (nb *NavBar) HandleClick(mx, my int) bool {
 	if float64(my) >= NavBarHeight {
 		return false
 	}
+	l := nb.layout()
 
-	// JellyCouch title → home
-	if PointInRect(mx, my, SectionPadding, 12, 180, 38) {
-		if nb.OnNavigate != nil {
-			nb.OnNavigate("home", "", "")
-		}
-		return true
-	}
-
-	// Home button
-	homeBtnX := 230.0
-	homeTw, _ := MeasureText("Home", FontSizeBody)
-	homeBtnW := homeTw + 28
-	if PointInRect(mx, my, homeBtnX, 12, homeBtnW, 38) {
+	// JellyCouch title or Home button → home
+	if l.title.Hit(mx, my) || l.home.Hit(mx, my) {
 		if nb.OnNavigate != nil {
 			nb.OnNavigate("home", "", "")
 		}
@@ -185,30 +235,24 @@ func (nb *NavBar) HandleClick(mx, my int) bool {
 	}
 
 	// Library buttons
-	libBtnX := homeBtnX + homeBtnW + 10
-	for _, view := range nb.LibraryViews {
-		tw, _ := MeasureText(view.Name, FontSizeBody)
-		btnW := tw + 28
-		if PointInRect(mx, my, libBtnX, 12, btnW, 38) {
+	for i, view := range nb.LibraryViews {
+		if l.libs[i].Hit(mx, my) {
 			if nb.OnNavigate != nil {
 				nb.OnNavigate("library", view.ID, view.Name)
 			}
 			return true
 		}
-		libBtnX += btnW + 10
 	}
 
 	// Search bar
-	searchX := float64(ScreenWidth)/2 - 200
-	if PointInRect(mx, my, searchX, 12, 400, 38) {
+	if l.search.Hit(mx, my) {
 		nb.Active = true
 		nb.focusSection = 1
 		return true
 	}
 
 	// Settings button
-	settingsX := float64(ScreenWidth) - SectionPadding - 100
-	if PointInRect(mx, my, settingsX, 12, 100, 38) {
+	if l.settings.Hit(mx, my) {
 		if nb.OnNavigate != nil {
 			nb.OnNavigate("settings", "", "")
 		}
@@ -216,14 +260,11 @@ func (nb *NavBar) HandleClick(mx, my int) bool {
 	}
 
 	// Discovery button
-	if nb.JellyseerrEnabled != nil && nb.JellyseerrEnabled() {
-		reqX := settingsX - 120
-		if PointInRect(mx, my, reqX, 12, 110, 38) {
-			if nb.OnNavigate != nil {
-				nb.OnNavigate("discovery", "", "")
-			}
-			return true
+	if l.discovery.W > 0 && l.discovery.Hit(mx, my) {
+		if nb.OnNavigate != nil {
+			nb.OnNavigate("discovery", "", "")
 		}
+		return true
 	}
 
 	return false
@@ -231,6 +272,8 @@ func (nb *NavBar) HandleClick(mx, my int) bool {
 
 // Draw renders the navbar overlay.
 func (nb *NavBar) Draw(dst *ebiten.Image) {
+	l := nb.layout()
+
 	// Solid background bar
 	vector.DrawFilledRect(dst, 0, 0, float32(ScreenWidth), float32(NavBarHeight), ColorBackground, false)
 	// Bottom separator line
@@ -244,37 +287,28 @@ func (nb *NavBar) Draw(dst *ebiten.Image) {
 	DrawText(dst, "JellyCouch", SectionPadding, 16, FontSizeTitle, homeColor)
 
 	// Home button
-	homeBtnX := 230.0
 	{
-		tw, _ := MeasureText("Home", FontSizeBody)
-		btnW := tw + 28
-		btnH := 38.0
-		btnY := 12.0
+		homeBtnX, btnY, btnW, btnH := l.home.X, l.home.Y, l.home.W, l.home.H
 		focused := nb.Active && nb.focusSection == 0 && nb.libNavIndex == 0
 		active := nb.ActiveScreenName == "Home"
 
 		if focused {
 			vector.DrawFilledRect(dst, float32(homeBtnX), float32(btnY), float32(btnW), float32(btnH), ColorPrimary, false)
-			DrawTextCentered(dst, "Home", homeBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorBackground)
+			DrawTextCentered(dst, T("nav.home"), homeBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorBackground)
 		} else if active {
 			vector.DrawFilledRect(dst, float32(homeBtnX), float32(btnY), float32(btnW), float32(btnH), ColorSurfaceHover, false)
 			vector.StrokeRect(dst, float32(homeBtnX), float32(btnY), float32(btnW), float32(btnH), 2, ColorPrimary, false)
-			DrawTextCentered(dst, "Home", homeBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorText)
+			DrawTextCentered(dst, T("nav.home"), homeBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorText)
 		} else {
 			vector.DrawFilledRect(dst, float32(homeBtnX), float32(btnY), float32(btnW), float32(btnH), ColorSurfaceHover, false)
 			vector.StrokeRect(dst, float32(homeBtnX), float32(btnY), float32(btnW), float32(btnH), 1, ColorPrimary, false)
-			DrawTextCentered(dst, "Home", homeBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorText)
+			DrawTextCentered(dst, T("nav.home"), homeBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorText)
 		}
-		homeBtnX += btnW + 10
 	}
 
 	// Library nav buttons
-	libBtnX := homeBtnX
 	for i, view := range nb.LibraryViews {
-		tw, _ := MeasureText(view.Name, FontSizeBody)
-		btnW := tw + 28
-		btnH := 38.0
-		btnY := 12.0
+		libBtnX, btnY, btnW, btnH := l.libs[i].X, l.libs[i].Y, l.libs[i].W, l.libs[i].H
 
 		focused := nb.Active && nb.focusSection == 0 && i+1 == nb.libNavIndex
 		active := strings.HasPrefix(nb.ActiveScreenName, "Library: "+view.Name)
@@ -291,20 +325,17 @@ func (nb *NavBar) Draw(dst *ebiten.Image) {
 			vector.StrokeRect(dst, float32(libBtnX), float32(btnY), float32(btnW), float32(btnH), 1, ColorPrimary, false)
 			DrawTextCentered(dst, view.Name, libBtnX+btnW/2, btnY+btnH/2, FontSizeBody, ColorText)
 		}
-		libBtnX += btnW + 10
 	}
 
 	// Search bar (center)
-	searchX := float64(ScreenWidth)/2 - 200
-	searchY := 12.0
-	searchW := 400.0
-	searchH := 38.0
+	searchX, searchY, searchW, searchH := l.search.X, l.search.Y, l.search.W, l.search.H
 	if nb.Active && nb.focusSection == 1 {
 		vector.DrawFilledRect(dst, float32(searchX), float32(searchY), float32(searchW), float32(searchH), ColorSurfaceHover, false)
 		vector.StrokeRect(dst, float32(searchX), float32(searchY), float32(searchW), float32(searchH), 2, ColorFocusBorder, false)
 		if nb.input.Text == "" {
-			DrawText(dst, "Search...", searchX+14, searchY+10, FontSizeBody, ColorTextMuted)
+			DrawText(dst, T("nav.search"), searchX+14, searchY+10, FontSizeBody, ColorTextMuted)
 		}
+		nb.input.DrawSelection(dst, searchX+14, searchY+10, FontSizeBody)
 		DrawText(dst, nb.input.DisplayText(), searchX+14, searchY+10, FontSizeBody, ColorText)
 	} else {
 		vector.DrawFilledRect(dst, float32(searchX), float32(searchY), float32(searchW), float32(searchH), ColorSurface, false)
@@ -312,57 +343,49 @@ func (nb *NavBar) Draw(dst *ebiten.Image) {
 		if nb.input.Text != "" {
 			DrawText(dst, nb.input.Text, searchX+14, searchY+10, FontSizeBody, ColorText)
 		} else {
-			DrawText(dst, "Search library...", searchX+14, searchY+10, FontSizeBody, ColorTextMuted)
+			DrawText(dst, T("nav.search_library"), searchX+14, searchY+10, FontSizeBody, ColorTextMuted)
 		}
 	}
 
-	// Right-side buttons
-	settingsX := float64(ScreenWidth) - SectionPadding - 100
-
 	// Discovery button (only when Jellyseerr configured)
-	if nb.JellyseerrEnabled != nil && nb.JellyseerrEnabled() {
-		reqX := settingsX - 120
-		reqY := 12.0
-		reqW := 110.0
-		reqH := 38.0
+	if l.discovery.W > 0 {
+		reqX, reqY, reqW, reqH := l.discovery.X, l.discovery.Y, l.discovery.W, l.discovery.H
 		focused := nb.Active && nb.focusSection == 2 && nb.navBtnIndex == 0
 		active := nb.ActiveScreenName == "Discovery"
 		if focused {
 			vector.DrawFilledRect(dst, float32(reqX), float32(reqY), float32(reqW), float32(reqH), ColorPrimary, false)
-			DrawTextCentered(dst, "Discovery", reqX+reqW/2+8, reqY+reqH/2, FontSizeBody, ColorBackground)
+			DrawTextCentered(dst, T("nav.discovery"), reqX+reqW/2+8, reqY+reqH/2, FontSizeBody, ColorBackground)
 			drawCompassIcon(dst, float32(reqX+16), float32(reqY+reqH/2), 7, ColorBackground)
 		} else if active {
 			vector.DrawFilledRect(dst, float32(reqX), float32(reqY), float32(reqW), float32(reqH), ColorSurfaceHover, false)
 			vector.StrokeRect(dst, float32(reqX), float32(reqY), float32(reqW), float32(reqH), 2, ColorPrimary, false)
-			DrawTextCentered(dst, "Discovery", reqX+reqW/2+8, reqY+reqH/2, FontSizeBody, ColorText)
+			DrawTextCentered(dst, T("nav.discovery"), reqX+reqW/2+8, reqY+reqH/2, FontSizeBody, ColorText)
 			drawCompassIcon(dst, float32(reqX+16), float32(reqY+reqH/2), 7, ColorPrimary)
 		} else {
 			vector.DrawFilledRect(dst, float32(reqX), float32(reqY), float32(reqW), float32(reqH), ColorSurfaceHover, false)
 			vector.StrokeRect(dst, float32(reqX), float32(reqY), float32(reqW), float32(reqH), 1, ColorPrimary, false)
-			DrawTextCentered(dst, "Discovery", reqX+reqW/2+8, reqY+reqH/2, FontSizeBody, ColorText)
+			DrawTextCentered(dst, T("nav.discovery"), reqX+reqW/2+8, reqY+reqH/2, FontSizeBody, ColorText)
 			drawCompassIcon(dst, float32(reqX+16), float32(reqY+reqH/2), 7, ColorPrimary)
 		}
 	}
 
 	// Settings button
-	settingsY := 12.0
-	settingsW := 100.0
-	settingsH := 38.0
+	settingsX, settingsY, settingsW, settingsH := l.settings.X, l.settings.Y, l.settings.W, l.settings.H
 	sfocused := nb.Active && nb.focusSection == 2 && nb.navBtnIndex == 1
 	sactive := nb.ActiveScreenName == "Settings"
 	if sfocused {
 		vector.DrawFilledRect(dst, float32(settingsX), float32(settingsY), float32(settingsW), float32(settingsH), ColorPrimary, false)
-		DrawTextCentered(dst, "Settings", settingsX+settingsW/2+8, settingsY+settingsH/2, FontSizeBody, ColorBackground)
+		DrawTextCentered(dst, T("nav.settings"), settingsX+settingsW/2+8, settingsY+settingsH/2, FontSizeBody, ColorBackground)
 		drawGearIcon(dst, float32(settingsX+16), float32(settingsY+settingsH/2), 7, ColorBackground)
 	} else if sactive {
 		vector.DrawFilledRect(dst, float32(settingsX), float32(settingsY), float32(settingsW), float32(settingsH), ColorSurfaceHover, false)
 		vector.StrokeRect(dst, float32(settingsX), float32(settingsY), float32(settingsW), float32(settingsH), 2, ColorTextSecondary, false)
-		DrawTextCentered(dst, "Settings", settingsX+settingsW/2+8, settingsY+settingsH/2, FontSizeBody, ColorText)
+		DrawTextCentered(dst, T("nav.settings"), settingsX+settingsW/2+8, settingsY+settingsH/2, FontSizeBody, ColorText)
 		drawGearIcon(dst, float32(settingsX+16), float32(settingsY+settingsH/2), 7, ColorTextSecondary)
 	} else {
 		vector.DrawFilledRect(dst, float32(settingsX), float32(settingsY), float32(settingsW), float32(settingsH), ColorSurfaceHover, false)
 		vector.StrokeRect(dst, float32(settingsX), float32(settingsY), float32(settingsW), float32(settingsH), 1, ColorTextSecondary, false)
-		DrawTextCentered(dst, "Settings", settingsX+settingsW/2+8, settingsY+settingsH/2, FontSizeBody, ColorText)
+		DrawTextCentered(dst, T("nav.settings"), settingsX+settingsW/2+8, settingsY+settingsH/2, FontSizeBody, ColorText)
 		drawGearIcon(dst, float32(settingsX+16), float32(settingsY+settingsH/2), 7, ColorTextSecondary)
 	}
 }
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// NowPlayingBar is the mini-player hanging from the bottom right of the
+// navbar while audio keeps playing in browse mode: play/pause and stop
+// buttons, the track title and a progress line. Clicking anywhere else on it
+// goes back to full playback. The Game owns it and calls Draw after the
+// screens and HandleClick before them.
+type NowPlayingBar struct {
+	Title    string
+	Paused   bool
+	Progress float64 // 0..1 through the track
+
+	OnPlayPause func()
+	OnStop      func()
+	OnOpen      func() // back to full playback
+
+	rect     ButtonRect
+	pauseBtn ButtonRect
+	stopBtn  ButtonRect
+}
+
+const (
+	nowPlayingW = 380.0
+	nowPlayingH = 40.0
+)
+
+// Draw renders the bar.
+func (nb *NowPlayingBar) Draw(dst *ebiten.Image) {
+	nb.rect = ButtonRect{
+		X: float64(ScreenWidth) - SectionPadding - nowPlayingW,
+		Y: NavBarHeight - nowPlayingH/2,
+		W: nowPlayingW,
+		H: nowPlayingH,
+	}
+	r := nb.rect
+	DrawFilledRoundRect(dst, float32(r.X), float32(r.Y), float32(r.W), float32(r.H), float32(r.H/2), ColorSurface)
+
+	nb.pauseBtn = ButtonRect{X: r.X + 4, Y: r.Y + 4, W: r.H - 8, H: r.H - 8}
+	b := nb.pauseBtn
+	cx, cy := float32(b.X+b.W/2), float32(b.Y+b.H/2)
+	vector.DrawFilledCircle(dst, cx, cy, float32(b.W/2), ColorPrimary, true)
+	if nb.Paused {
+		drawPlayIcon(dst, cx+1, cy, 7, ColorText)
+	} else {
+		drawPauseIcon(dst, cx, cy, 7, ColorText)
+	}
+
+	nb.stopBtn = ButtonRect{X: b.X + b.W + 4, Y: b.Y, W: b.W, H: b.H}
+	s := nb.stopBtn
+	vector.DrawFilledRect(dst, float32(s.X+s.W/2-6), float32(s.Y+s.H/2-6), 12, 12, ColorText, false)
+
+	tx := s.X + s.W + 10
+	title := truncateText(nb.Title, r.X+r.W-tx-20, FontSizeSmall)
+	DrawText(dst, title, tx, r.Y+(r.H-FontSizeSmall)/2-3, FontSizeSmall, ColorText)
+
+	// Progress line under the title
+	lw := r.X + r.W - tx - 20
+	ly := float32(r.Y + r.H - 10)
+	vector.DrawFilledRect(dst, float32(tx), ly, float32(lw), 2, ColorSurfaceHover, false)
+	p := max(0, min(1, nb.Progress))
+	vector.DrawFilledRect(dst, float32(tx), ly, float32(lw*p), 2, ColorPrimary, false)
+}
+
+// HandleClick handles a click at (mx, my), reporting whether it hit the bar.
+func (nb *NowPlayingBar) HandleClick(mx, my int) bool {
+	if nb.rect.W == 0 || !nb.rect.Hit(mx, my) {
+		return false
+	}
+	var fn func()
+	switch {
+	case nb.pauseBtn.Hit(mx, my):
+		fn = nb.OnPlayPause
+	case nb.stopBtn.Hit(mx, my):
+		fn = nb.OnStop
+	default:
+		fn = nb.OnOpen
+	}
+	if fn != nil {
+		fn()
+	}
+	return true
+}
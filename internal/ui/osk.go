@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// OnScreenKeyboardEnabled shows the on-screen keyboard for focused text
+// fields until a physical keyboard is used (ui.on_screen_keyboard).
+var OnScreenKeyboardEnabled = true
+
+// TextEditor is implemented by screens with a text field that can take focus,
+// so the on-screen keyboard can type into it.
+type TextEditor interface {
+	// EditText calls edit with the focused text input, under the screen's
+	// lock, and reports whether a text input has focus.
+	EditText(edit func(ti *TextInput)) bool
+}
+
+const (
+	oskCols   = 10
+	oskKeyW   = 64.0
+	oskKeyH   = 56.0
+	oskKeyGap = 8.0
+	oskPad    = 20.0
+)
+
+type oskAction int
+
+const (
+	oskChar oskAction = iota
+	oskShift
+	oskSpace
+	oskBackspace
+	oskDone
+)
+
+type oskKey struct {
+	label  string // the character, or a translation key for the wide keys
+	action oskAction
+	col    int // first column covered
+	span   int // columns covered
+}
+
+// oskRows is the layout: four rows of characters and a row of wide keys.
+var oskRows = func() [][]oskKey {
+	var rows [][]oskKey
+	for _, chars := range []string{"1234567890", "qwertyuiop", "asdfghjkl'", "zxcvbnm-./"} {
+		var row []oskKey
+		for i, r := range chars {
+			row = append(row, oskKey{label: string(r), action: oskChar, col: i, span: 1})
+		}
+		rows = append(rows, row)
+	}
+	return append(rows, []oskKey{
+		{"osk.shift", oskShift, 0, 2},
+		{"osk.space", oskSpace, 2, 4},
+		{"osk.delete", oskBackspace, 6, 2},
+		{"osk.done", oskDone, 8, 2},
+	})
+}()
+
+// OnScreenKeyboard is a D-pad driven keyboard drawn at the bottom of the
+// screen. Directions move between keys, OK presses one, Back closes it.
+type OnScreenKeyboard struct {
+	row, col int  // focused key; col is the key's index within its row
+	shift    bool // next letters are upper case
+	rects    [][]ButtonRect
+}
+
+// Update handles input for one frame, typing into ti. It reports whether the
+// keyboard should close and whether Done (rather than Back) closed it.
+func (k *OnScreenKeyboard) Update(ti *TextInput) (closed, submit bool) {
+	dir, enter, back := InputState()
+	if back {
+		return true, false
+	}
+
+	if mx, my, clicked := MouseJustClicked(); clicked {
+		for r, row := range k.rects {
+			for c, rect := range row {
+				if rect.Hit(mx, my) {
+					k.row, k.col = r, c
+					return k.press(ti)
+				}
+			}
+		}
+		if !k.panelRect().Hit(mx, my) {
+			return true, false
+		}
+	}
+
+	switch dir {
+	case DirLeft:
+		if k.col > 0 {
+			k.col--
+		} else {
+			k.col = len(oskRows[k.row]) - 1
+		}
+	case DirRight:
+		if k.col < len(oskRows[k.row])-1 {
+			k.col++
+		} else {
+			k.col = 0
+		}
+	case DirUp:
+		if k.row > 0 {
+			k.moveRow(k.row - 1)
+		}
+	case DirDown:
+		if k.row < len(oskRows)-1 {
+			k.moveRow(k.row + 1)
+		}
+	}
+
+	if enter {
+		return k.press(ti)
+	}
+	return false, false
+}
+
+// moveRow focuses the key in row that covers the focused key's center column.
+func (k *OnScreenKeyboard) moveRow(row int) {
+	cur := oskRows[k.row][k.col]
+	center := cur.col + cur.span/2
+	k.row = row
+	for i, key := range oskRows[row] {
+		if center >= key.col && center < key.col+key.span {
+			k.col = i
+			return
+		}
+	}
+	k.col = len(oskRows[row]) - 1
+}
+
+func (k *OnScreenKeyboard) press(ti *TextInput) (closed, submit bool) {
+	key := oskRows[k.row][k.col]
+	switch key.action {
+	case oskChar:
+		s := key.label
+		if k.shift {
+			s = strings.ToUpper(s)
+			k.shift = false
+		}
+		ti.Insert(s)
+	case oskShift:
+		k.shift = !k.shift
+	case oskSpace:
+		ti.Insert(" ")
+	case oskBackspace:
+		if ti.HasSelection() {
+			ti.deleteSelection()
+		} else if ti.Cursor > 0 {
+			ti.deleteRange(ti.Cursor-1, ti.Cursor)
+		}
+	case oskDone:
+		return true, true
+	}
+	return false, false
+}
+
+func (k *OnScreenKeyboard) panelRect() ButtonRect {
+	w := oskCols*oskKeyW + (oskCols-1)*oskKeyGap + oskPad*2
+	h := float64(len(oskRows))*oskKeyH + float64(len(oskRows)-1)*oskKeyGap + oskPad*2
+	return ButtonRect{X: (float64(ScreenWidth) - w) / 2, Y: float64(ScreenHeight) - h - 40, W: w, H: h}
+}
+
+func (k *OnScreenKeyboard) Draw(dst *ebiten.Image) {
+	panel := k.panelRect()
+	vector.DrawFilledRect(dst, float32(panel.X), float32(panel.Y), float32(panel.W), float32(panel.H), ColorSurface, false)
+	vector.StrokeRect(dst, float32(panel.X), float32(panel.Y), float32(panel.W), float32(panel.H), 2, ColorPrimary, false)
+
+	k.rects = make([][]ButtonRect, len(oskRows))
+	for r, row := range oskRows {
+		k.rects[r] = make([]ButtonRect, len(row))
+		y := panel.Y + oskPad + float64(r)*(oskKeyH+oskKeyGap)
+		for c, key := range row {
+			x := panel.X + oskPad + float64(key.col)*(oskKeyW+oskKeyGap)
+			w := float64(key.span)*oskKeyW + float64(key.span-1)*oskKeyGap
+			k.rects[r][c] = ButtonRect{X: x, Y: y, W: w, H: oskKeyH}
+
+			focused := r == k.row && c == k.col
+			bg, fg := ColorSurfaceHover, ColorText
+			if focused {
+				bg, fg = ColorPrimary, ColorBackground
+			} else if key.action == oskShift && k.shift {
+				fg = ColorPrimary
+			}
+			vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), oskKeyH, bg, false)
+
+			label := key.label
+			if key.action != oskChar {
+				label = T(label)
+			} else if k.shift {
+				label = strings.ToUpper(label)
+			}
+			DrawTextCentered(dst, label, x+w/2, y+oskKeyH/2, FontSizeBody, fg)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package ui
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/depeter/jellycouch/internal/cache"
+	"github.com/depeter/jellycouch/internal/jellyfin"
+)
+
+// Filmography orders offered by the person screen's sort pill
+const (
+	personSortYear = iota
+	personSortRating
+	personSortName
+)
+
+var personSortLabels = []string{"Year", "Rating", "Name"}
+
+const (
+	personPhotoW = 180.0
+	personPhotoH = 270.0
+	personPillH  = 38.0
+)
+
+// PersonScreen shows a cast or crew member of the Jellyfin library: their
+// photo and biography, and the movies and shows crediting them, sortable by
+// year, rating or name. Selecting a title opens its detail.
+type PersonScreen struct {
+	client   *jellyfin.Client
+	imgCache *cache.ImageCache
+	person   jellyfin.Person
+
+	// loadCtx is cancelled in OnExit, aborting the screen's fetches
+	loadCtx    context.Context
+	cancelLoad context.CancelFunc
+
+	overview  string
+	photo     *ebiten.Image
+	items     []jellyfin.MediaItem
+	gridItems []GridItem // parallel to items
+	grid      *FocusGrid
+	loaded    bool
+	loading   bool
+	loadError string
+	gridBaseY float64 // set during Draw, below the biography
+
+	sortIndex   int // personSortYear, personSortRating or personSortName
+	sortFocused bool
+	sortRect    ButtonRect
+
+	ScrollState
+
+	OnItemSelected func(item jellyfin.MediaItem)
+
+	errDisplay ErrorDisplay
+	mu         sync.Mutex
+}
+
+func NewPersonScreen(client *jellyfin.Client, imgCache *cache.ImageCache, person jellyfin.Person) *PersonScreen {
+	cols := (ScreenWidth - SectionPadding*2) / (PosterWidth + PosterGap)
+	ps := &PersonScreen{
+		client:   client,
+		imgCache: imgCache,
+		person:   person,
+		grid:     NewFocusGrid(cols, 0),
+	}
+	ps.loadCtx, ps.cancelLoad = context.WithCancel(client.Context())
+	return ps
+}
+
+func (ps *PersonScreen) Name() string { return ps.person.Name }
+
+func (ps *PersonScreen) OnEnter() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if !ps.loaded && !ps.loading {
+		ps.loading = true
+		go ps.loadData()
+		go ps.loadPhoto()
+	}
+}
+
+func (ps *PersonScreen) OnExit() { ps.cancelLoad() }
+
+func (ps *PersonScreen) loadPhoto() {
+	url := ps.client.GetPosterURL(ps.person.ID)
+	ps.imgCache.LoadAsync(url, func(img *ebiten.Image) {
+		ps.mu.Lock()
+		ps.photo = img
+		ps.mu.Unlock()
+	})
+}
+
+func (ps *PersonScreen) loadData() {
+	var overview string
+	if full, err := ps.client.GetItemContext(ps.loadCtx, ps.person.ID); err != nil {
+		log.Printf("Failed to load person %s: %v", ps.person.Name, err)
+	} else {
+		overview = full.Overview
+	}
+	items, err := ps.client.GetItemsByPersonContext(ps.loadCtx, ps.person.ID)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.loading = false
+	if err != nil {
+		ps.loadError = "Failed to load: " + err.Error()
+		return
+	}
+
+	ps.overview = overview
+	ps.items = items
+	ps.loaded = true
+	ps.loadError = ""
+	ps.gridItems = make([]GridItem, len(items))
+	for i, item := range items {
+		ps.gridItems[i] = GridItemFromMediaItem(item)
+	}
+	ps.grid.SetTotal(len(items))
+	// Cached posters are placed by index, so load before sorting
+	LoadGridItemImages(ps.client, ps.imgCache, &ps.gridItems, items, &ps.mu)
+	ps.sortItems()
+}
+
+// sortItems orders the filmography by the chosen sort, keeping gridItems
+// in step with items. Caller must hold ps.mu.
+func (ps *PersonScreen) sortItems() {
+	order := make([]int, len(ps.items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		x, y := ps.items[a], ps.items[b]
+		switch ps.sortIndex {
+		case personSortYear:
+			if c := cmp.Compare(y.Year, x.Year); c != 0 {
+				return c
+			}
+		case personSortRating:
+			if c := cmp.Compare(y.CommunityRating, x.CommunityRating); c != 0 {
+				return c
+			}
+		}
+		return strings.Compare(strings.ToLower(x.Name), strings.ToLower(y.Name))
+	})
+	items := make([]jellyfin.MediaItem, len(order))
+	gridItems := make([]GridItem, len(order))
+	for i, j := range order {
+		items[i], gridItems[i] = ps.items[j], ps.gridItems[j]
+	}
+	ps.items, ps.gridItems = items, gridItems
+}
+
+// cycleSort moves the sort pill by step and re-sorts, keeping focus on the
+// first title. Caller must hold ps.mu.
+func (ps *PersonScreen) cycleSort(step int) {
+	n := len(personSortLabels)
+	ps.sortIndex = (ps.sortIndex + step + n) % n
+	ps.sortItems()
+	ps.grid.Focused = 0
+}
+
+func (ps *PersonScreen) Update() (*ScreenTransition, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	dir, enter, back := InputState()
+	if back {
+		return &ScreenTransition{Type: TransitionPop}, nil
+	}
+
+	ps.ScrollState.HandleMouseWheel()
+
+	mx, my, clicked := MouseJustClicked()
+	if clicked && ps.errDisplay.HandleClick(mx, my, ps.loadError) {
+		return nil, nil
+	}
+	if clicked && ps.loaded {
+		if ps.sortRect.Hit(mx, my) {
+			ps.sortFocused = true
+			ps.cycleSort(1)
+			return nil, nil
+		}
+		if idx, ok := ps.grid.HandleClick(mx, my, SectionPadding, ps.gridBaseY-ps.ScrollY); ok {
+			ps.sortFocused = false
+			ps.grid.Focused = idx
+			ps.selectItem(idx)
+			return nil, nil
+		}
+	}
+
+	if !ps.loaded || len(ps.gridItems) == 0 {
+		if dir == DirUp {
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		}
+		return nil, nil
+	}
+
+	if ps.sortFocused {
+		switch dir {
+		case DirUp:
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		case DirDown:
+			ps.sortFocused = false
+		case DirLeft:
+			ps.cycleSort(-1)
+		case DirRight:
+			ps.cycleSort(1)
+		}
+		if enter {
+			ps.cycleSort(1)
+		}
+		return nil, nil
+	}
+
+	if dir != DirNone {
+		if dir == DirUp && ps.grid.FocusedRow() == 0 {
+			ps.sortFocused = true
+			ps.TargetScrollY = 0
+			return nil, nil
+		}
+		ps.grid.Update(dir)
+		ps.ensureVisible()
+	}
+
+	if enter {
+		ps.selectItem(ps.grid.Focused)
+	}
+	return nil, nil
+}
+
+// selectItem opens the detail of a title. Caller must hold ps.mu.
+func (ps *PersonScreen) selectItem(idx int) {
+	if idx < 0 || idx >= len(ps.items) || ps.OnItemSelected == nil {
+		return
+	}
+	ps.OnItemSelected(ps.items[idx])
+}
+
+func (ps *PersonScreen) ensureVisible() {
+	rowH := ps.grid.RowHeight()
+	ps.CenterRow(ps.gridBaseY+float64(ps.grid.FocusedRow())*rowH, rowH, NavBarHeight)
+}
+
+func (ps *PersonScreen) Draw(dst *ebiten.Image) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.ScrollState.Animate()
+
+	top := float64(NavBarHeight+16) - ps.ScrollY
+	if ps.photo != nil {
+		DrawImageCover(dst, ps.photo, SectionPadding, top, personPhotoW, personPhotoH)
+	} else {
+		vector.DrawFilledRect(dst, SectionPadding, float32(top), personPhotoW, personPhotoH, ColorSurface, false)
+	}
+
+	x := float64(SectionPadding) + personPhotoW + 32
+	y := top
+	DrawText(dst, ps.person.Name, x, y, FontSizeTitle, ColorPrimary)
+	y += FontSizeTitle + 12
+	if ps.person.Type != "" {
+		DrawText(dst, ps.person.Type, x, y, FontSizeSmall, ColorTextMuted)
+		y += FontSizeSmall + 12
+	}
+
+	if ps.loadError != "" && !ps.loaded {
+		ps.errDisplay.Draw(dst, ps.loadError, x, y+40, FontSizeBody)
+		return
+	}
+	if !ps.loaded {
+		DrawTextCentered(dst, T("common.loading"), float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+			FontSizeHeading, ColorTextSecondary)
+		return
+	}
+
+	if bio := ps.overview; bio != "" {
+		if r := []rune(bio); len(r) > personBioMaxLen {
+			bio = string(r[:personBioMaxLen]) + "..."
+		}
+		maxW := float64(ScreenWidth) - SectionPadding - x
+		y += DrawTextWrapped(dst, bio, x, y, maxW, FontSizeSmall, ColorTextSecondary)
+	}
+	y = max(y, top+personPhotoH) + 32
+
+	heading := fmt.Sprintf("Filmography (%s)", FormatCount(len(ps.gridItems)))
+	DrawText(dst, heading, SectionPadding, y+(personPillH-FontSizeHeading)/2, FontSizeHeading, ColorText)
+	ps.drawSortPill(dst, y)
+	y += personPillH + 24
+	ps.gridBaseY = y + ps.ScrollY
+
+	if len(ps.gridItems) == 0 {
+		DrawText(dst, "No movies or shows in your library", SectionPadding, y, FontSizeBody, ColorTextSecondary)
+		return
+	}
+
+	for i, item := range ps.gridItems {
+		gx, gy := ps.grid.ItemRect(i, SectionPadding, y)
+		if gy+PosterHeight < 0 || gy > float64(ScreenHeight) {
+			continue
+		}
+		drawPosterItem(dst, item, gx, gy, !ps.sortFocused && i == ps.grid.Focused)
+	}
+	ps.SetGridRows((len(ps.gridItems)+ps.grid.Cols-1)/ps.grid.Cols, ps.grid.RowHeight(), ps.gridBaseY, 24)
+}
+
+// drawSortPill draws the sort selector at the right end of the filmography
+// heading, styled like a filter bar pill. Caller must hold ps.mu.
+func (ps *PersonScreen) drawSortPill(dst *ebiten.Image, y float64) {
+	label := "Sort: " + personSortLabels[ps.sortIndex]
+	tw, _ := MeasureText(label, FontSizeBody)
+	w := tw + filterPillPadX*2
+	x := float64(ScreenWidth) - SectionPadding - w
+	ps.sortRect = ButtonRect{X: x, Y: y, W: w, H: personPillH}
+
+	if ps.sortFocused {
+		vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), personPillH, ColorPrimary, false)
+		DrawTextCentered(dst, label, x+w/2, y+personPillH/2, FontSizeBody, ColorBackground)
+		return
+	}
+	vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), personPillH, ColorSurface, false)
+	vector.StrokeRect(dst, float32(x), float32(y), float32(w), personPillH, 1, ColorTextMuted, false)
+	DrawTextCentered(dst, label, x+w/2, y+personPillH/2, FontSizeBody, ColorTextSecondary)
+}
@@ -0,0 +1,289 @@
+package ui
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/depeter/jellycouch/internal/cache"
+	"github.com/depeter/jellycouch/internal/jellyfin"
+)
+
+const (
+	playAllBtnW = 140
+	playAllBtnH = 36
+)
+
+// PlaylistScreen lists the user's playlists, or the entries of one playlist
+// with a "Play All" button that plays them back to back.
+type PlaylistScreen struct {
+	client   *jellyfin.Client
+	imgCache *cache.ImageCache
+
+	playlist *jellyfin.MediaItem // nil = list all playlists
+
+	items     []jellyfin.MediaItem
+	gridItems []GridItem
+	grid      *FocusGrid
+
+	focusPlayAll bool // header "Play All" button has focus
+	playAllRect  ButtonRect
+
+	loaded    bool
+	loading   bool
+	loadError string
+	ScrollState
+
+	OnPlaylistSelected func(playlist jellyfin.MediaItem)
+	OnItemSelected     func(item jellyfin.MediaItem)
+	OnPlayAll          func(items []jellyfin.MediaItem)
+
+	errDisplay ErrorDisplay
+	mu         sync.Mutex
+}
+
+// NewPlaylistScreen creates a screen for playlist, or for all playlists if nil.
+func NewPlaylistScreen(client *jellyfin.Client, imgCache *cache.ImageCache, playlist *jellyfin.MediaItem) *PlaylistScreen {
+	cols := (ScreenWidth - SectionPadding*2) / (PosterWidth + PosterGap)
+	return &PlaylistScreen{
+		client:   client,
+		imgCache: imgCache,
+		playlist: playlist,
+		grid:     NewFocusGrid(cols, 0),
+	}
+}
+
+func (ps *PlaylistScreen) Name() string {
+	if ps.playlist != nil {
+		return "Playlist: " + ps.playlist.Name
+	}
+	return "Playlists"
+}
+
+func (ps *PlaylistScreen) OnEnter() {
+	if !ps.loaded && !ps.loading {
+		ps.loading = true
+		go ps.loadData()
+	}
+}
+
+func (ps *PlaylistScreen) OnExit() {}
+
+func (ps *PlaylistScreen) loadData() {
+	var items []jellyfin.MediaItem
+	var err error
+	if ps.playlist != nil {
+		items, err = ps.client.GetPlaylistItems(ps.playlist.ID)
+	} else {
+		items, err = ps.client.GetPlaylists()
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.loading = false
+	if err != nil {
+		ps.loadError = "Failed to load: " + err.Error()
+		return
+	}
+
+	ps.items = items
+	ps.grid.SetTotal(len(items))
+	ps.gridItems = make([]GridItem, len(items))
+	for i, item := range items {
+		ps.gridItems[i] = GridItemFromMediaItem(item)
+	}
+	LoadGridItemImages(ps.client, ps.imgCache, &ps.gridItems, ps.items, &ps.mu)
+	ps.loaded = true
+	ps.loadError = ""
+	ps.focusPlayAll = ps.playlist != nil && len(ps.playableItems()) > 0
+}
+
+// playableItems returns the playlist entries that can be queued for playback.
+func (ps *PlaylistScreen) playableItems() []jellyfin.MediaItem {
+	var playable []jellyfin.MediaItem
+	for _, item := range ps.items {
+		if isPlayableType(item.Type) {
+			playable = append(playable, item)
+		}
+	}
+	return playable
+}
+
+// hasPlayAll reports whether the "Play All" button is shown.
+func (ps *PlaylistScreen) hasPlayAll() bool {
+	return ps.playlist != nil && ps.loaded && len(ps.playableItems()) > 0
+}
+
+// gridBaseY returns the Y position where the poster grid starts.
+func (ps *PlaylistScreen) gridBaseY() float64 {
+	return float64(NavBarHeight*2) + 20
+}
+
+func (ps *PlaylistScreen) Update() (*ScreenTransition, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	dir, enter, back := InputState()
+	if back {
+		return &ScreenTransition{Type: TransitionPop}, nil
+	}
+
+	ps.ScrollState.HandleMouseWheel()
+
+	mx, my, clicked := MouseJustClicked()
+	if clicked && ps.errDisplay.HandleClick(mx, my, ps.loadError) {
+		return nil, nil
+	}
+	if clicked && ps.hasPlayAll() {
+		r := ps.playAllRect
+		if PointInRect(mx, my, r.X, r.Y, r.W, r.H) {
+			ps.focusPlayAll = true
+			ps.playAll()
+			return nil, nil
+		}
+	}
+	if clicked && ps.loaded {
+		if idx, ok := ps.grid.HandleClick(mx, my, SectionPadding, ps.gridBaseY()-ps.ScrollY); ok {
+			ps.focusPlayAll = false
+			ps.grid.Focused = idx
+			ps.selectItem(idx)
+			return nil, nil
+		}
+	}
+
+	if ps.focusPlayAll {
+		switch {
+		case dir == DirUp:
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		case dir == DirDown && len(ps.gridItems) > 0:
+			ps.focusPlayAll = false
+		case enter:
+			ps.playAll()
+		}
+		return nil, nil
+	}
+
+	if !ps.loaded || len(ps.gridItems) == 0 {
+		if dir == DirUp {
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		}
+		return nil, nil
+	}
+
+	if dir != DirNone {
+		if dir == DirUp && ps.grid.FocusedRow() == 0 {
+			if ps.hasPlayAll() {
+				ps.focusPlayAll = true
+				return nil, nil
+			}
+			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
+		}
+		ps.grid.Update(dir)
+		ps.ensureVisible()
+	}
+
+	if enter {
+		ps.selectItem(ps.grid.Focused)
+	}
+	return nil, nil
+}
+
+// selectItem opens a playlist, or the detail screen for a playlist entry.
+// Caller must hold ps.mu.
+func (ps *PlaylistScreen) selectItem(idx int) {
+	if idx < 0 || idx >= len(ps.items) {
+		return
+	}
+	item := ps.items[idx]
+	if ps.playlist == nil {
+		if ps.OnPlaylistSelected != nil {
+			ps.OnPlaylistSelected(item)
+		}
+		return
+	}
+	if ps.OnItemSelected != nil {
+		ps.OnItemSelected(item)
+	}
+}
+
+// playAll starts queued playback of every playable entry. Caller must hold ps.mu.
+func (ps *PlaylistScreen) playAll() {
+	if items := ps.playableItems(); len(items) > 0 && ps.OnPlayAll != nil {
+		ps.OnPlayAll(items)
+	}
+}
+
+func (ps *PlaylistScreen) ensureVisible() {
+	rowH := ps.grid.RowHeight()
+	ps.CenterRow(ps.gridBaseY()+float64(ps.grid.FocusedRow())*rowH, rowH, ps.gridBaseY())
+}
+
+func (ps *PlaylistScreen) Draw(dst *ebiten.Image) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.ScrollState.Animate()
+
+	title := T("playlist.title")
+	if ps.playlist != nil {
+		title = ps.playlist.Name
+	}
+	DrawText(dst, title, SectionPadding, NavBarHeight+16, FontSizeTitle, ColorText)
+
+	if ps.loaded {
+		countStr := Tf("playlist.count", len(ps.items))
+		if ps.playlist != nil {
+			countStr = Tf("playlist.item_count", len(ps.items))
+		}
+		DrawText(dst, countStr, float64(ScreenWidth)-200, NavBarHeight+24, FontSizeSmall, ColorTextMuted)
+	}
+
+	if ps.hasPlayAll() {
+		tw, _ := MeasureText(title, FontSizeTitle)
+		bx := float64(SectionPadding) + tw + 32
+		by := float64(NavBarHeight) + 16
+		ps.playAllRect = ButtonRect{X: bx, Y: by, W: playAllBtnW, H: playAllBtnH}
+		bg, fg := ColorSurface, ColorTextSecondary
+		if ps.focusPlayAll {
+			bg, fg = ColorPrimary, ColorText
+		}
+		vector.DrawFilledRect(dst, float32(bx), float32(by), playAllBtnW, playAllBtnH, bg, false)
+		DrawTextCentered(dst, T("playlist.play_all"), bx+playAllBtnW/2, by+playAllBtnH/2, FontSizeBody, fg)
+	}
+
+	if ps.loadError != "" && !ps.loaded {
+		errX := float64(ScreenWidth)/2 - 300
+		errY := float64(ScreenHeight)/2 - 20
+		ps.errDisplay.Draw(dst, ps.loadError, errX, errY, FontSizeBody)
+		DrawTextCentered(dst, T("common.press_esc_back"), float64(ScreenWidth)/2, float64(ScreenHeight)/2+20,
+			FontSizeSmall, ColorTextMuted)
+		return
+	}
+
+	if !ps.loaded {
+		DrawTextCentered(dst, T("common.loading"), float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+			FontSizeHeading, ColorTextSecondary)
+		return
+	}
+
+	if len(ps.gridItems) == 0 {
+		msg := T("playlist.none")
+		if ps.playlist != nil {
+			msg = T("playlist.empty")
+		}
+		DrawTextCentered(dst, msg, float64(ScreenWidth)/2, float64(ScreenHeight)/2,
+			FontSizeHeading, ColorTextSecondary)
+		return
+	}
+
+	baseY := ps.gridBaseY() - ps.ScrollY
+	for i, item := range ps.gridItems {
+		x, y := ps.grid.ItemRect(i, SectionPadding, baseY)
+		if y+PosterHeight < 0 || y > float64(ScreenHeight) {
+			continue
+		}
+		drawPosterItem(dst, item, x, y, !ps.focusPlayAll && i == ps.grid.Focused)
+	}
+	ps.SetGridRows((len(ps.gridItems)+ps.grid.Cols-1)/ps.grid.Cols, ps.grid.RowHeight(), ps.gridBaseY(), 24)
+}
@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// RemoteKey is a TV remote button read from a Linux input device (HDMI-CEC,
+// IR receivers). Other platforms never report remote keys.
+type RemoteKey int
+
+const (
+	RemoteUp RemoteKey = iota
+	RemoteDown
+	RemoteLeft
+	RemoteRight
+	RemoteOK
+	RemoteBack
+	RemoteMenu
+	RemoteInfo
+	RemotePlayPause
+	RemotePlay
+	RemotePause
+	RemoteStop
+	RemoteFastForward
+	RemoteRewind
+	remoteKeyCount
+)
+
+// RemoteAction describes a remote button for config and the settings editor.
+type RemoteAction struct {
+	Key   RemoteKey
+	Name  string // key in [input.remote_keys]
+	Label string
+}
+
+// RemoteActions lists every remote button in display order.
+var RemoteActions = []RemoteAction{
+	{RemoteUp, "up", "Up"},
+	{RemoteDown, "down", "Down"},
+	{RemoteLeft, "left", "Left"},
+	{RemoteRight, "right", "Right"},
+	{RemoteOK, "ok", "OK"},
+	{RemoteBack, "back", "Back"},
+	{RemoteMenu, "menu", "Menu"},
+	{RemoteInfo, "info", "Info"},
+	{RemotePlayPause, "play_pause", "Play/Pause"},
+	{RemotePlay, "play", "Play"},
+	{RemotePause, "pause", "Pause"},
+	{RemoteStop, "stop", "Stop"},
+	{RemoteFastForward, "fast_forward", "Fast Forward"},
+	{RemoteRewind, "rewind", "Rewind"},
+}
+
+// defaultRemoteCodes are the Linux key codes (input-event-codes.h) that CEC
+// and IR remotes usually send for each button.
+var defaultRemoteCodes = map[RemoteKey][]int{
+	RemoteUp:          {103},          // KEY_UP
+	RemoteDown:        {108},          // KEY_DOWN
+	RemoteLeft:        {105},          // KEY_LEFT
+	RemoteRight:       {106},          // KEY_RIGHT
+	RemoteOK:          {28, 352, 353}, // KEY_ENTER, KEY_OK, KEY_SELECT
+	RemoteBack:        {158, 174, 1},  // KEY_BACK, KEY_EXIT, KEY_ESC
+	RemoteMenu:        {139, 438},     // KEY_MENU, KEY_CONTEXT_MENU
+	RemoteInfo:        {358},          // KEY_INFO
+	RemotePlayPause:   {164},          // KEY_PLAYPAUSE
+	RemotePlay:        {207, 200},     // KEY_PLAY, KEY_PLAYCD
+	RemotePause:       {119, 201},     // KEY_PAUSE, KEY_PAUSECD
+	RemoteStop:        {128, 166},     // KEY_STOP, KEY_STOPCD
+	RemoteFastForward: {208},          // KEY_FASTFORWARD
+	RemoteRewind:      {168},          // KEY_REWIND
+}
+
+var (
+	remoteMu      sync.Mutex
+	remotePending [remoteKeyCount]bool // pressed since the last PollRemote
+	remoteDevices []string             // lower-cased substrings of device names to read
+	remoteCodes   = remoteCodeTable(nil)
+	remoteFrame   [remoteKeyCount]bool // pressed this frame; only touched on the game goroutine
+)
+
+// remoteCodeTable builds the code → button lookup. Buttons named in overrides
+// use only those codes; a code assigned by an override wins over a default.
+func remoteCodeTable(overrides map[string][]int) map[uint16]RemoteKey {
+	table := make(map[uint16]RemoteKey)
+	for _, a := range RemoteActions {
+		if _, ok := overrides[a.Name]; ok {
+			continue
+		}
+		for _, c := range defaultRemoteCodes[a.Key] {
+			table[uint16(c)] = a.Key
+		}
+	}
+	for _, a := range RemoteActions {
+		for _, c := range overrides[a.Name] {
+			table[uint16(c)] = a.Key
+		}
+	}
+	return table
+}
+
+// SetRemoteKeyCodes replaces the codes of the buttons named in overrides
+// (see RemoteActions); other buttons keep their defaults.
+func SetRemoteKeyCodes(overrides map[string][]int) {
+	table := remoteCodeTable(overrides)
+	remoteMu.Lock()
+	remoteCodes = table
+	remoteMu.Unlock()
+}
+
+// RemoteKeyCodes returns the codes currently mapped to k, in ascending order.
+func RemoteKeyCodes(k RemoteKey) []int {
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+	var codes []int
+	for c, rk := range remoteCodes {
+		if rk == k {
+			codes = append(codes, int(c))
+		}
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// remoteKeyForCode looks up the button a key code is mapped to.
+func remoteKeyForCode(code uint16) (RemoteKey, bool) {
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+	k, ok := remoteCodes[code]
+	return k, ok
+}
+
+// SetRemoteDevices sets which input devices are treated as remotes: any whose
+// name contains one of the given strings (case-insensitive). Keyboards must
+// not match, or their arrows would be handled twice.
+func SetRemoteDevices(names []string) {
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+	remoteDevices = remoteDevices[:0]
+	for _, n := range names {
+		if n = strings.ToLower(strings.TrimSpace(n)); n != "" {
+			remoteDevices = append(remoteDevices, n)
+		}
+	}
+}
+
+func remoteDevicesConfigured() bool {
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+	return len(remoteDevices) > 0
+}
+
+// isRemoteDevice reports whether a device name matches SetRemoteDevices.
+func isRemoteDevice(name string) bool {
+	name = strings.ToLower(name)
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+	for _, n := range remoteDevices {
+		if strings.Contains(name, n) {
+			return true
+		}
+	}
+	return false
+}
+
+// pushRemote queues a remote press for the next frame. Safe from any goroutine.
+func pushRemote(k RemoteKey) {
+	remoteMu.Lock()
+	remotePending[k] = true
+	remoteMu.Unlock()
+}
+
+// PollRemote moves remote presses queued by the device readers into the
+// current frame. Call once at the start of each Update.
+func PollRemote() {
+	remoteMu.Lock()
+	remoteFrame = remotePending
+	remotePending = [remoteKeyCount]bool{}
+	remoteMu.Unlock()
+}
+
+// RemoteJustPressed reports whether k was pressed on a remote this frame.
+func RemoteJustPressed(k RemoteKey) bool {
+	return k >= 0 && k < remoteKeyCount && remoteFrame[k]
+}
+
+// remoteKeyFor maps navigation keys to the remote button with the same role.
+var remoteKeyFor = map[ebiten.Key]RemoteKey{
+	ebiten.KeyArrowUp:    RemoteUp,
+	ebiten.KeyArrowDown:  RemoteDown,
+	ebiten.KeyArrowLeft:  RemoteLeft,
+	ebiten.KeyArrowRight: RemoteRight,
+	ebiten.KeyEnter:      RemoteOK,
+	ebiten.KeyEscape:     RemoteBack,
+}
+
+// KeyJustPressed is inpututil.IsKeyJustPressed that also accepts the remote
+// button for arrows, Enter and Escape.
+func KeyJustPressed(key ebiten.Key) bool {
+	if inpututil.IsKeyJustPressed(key) {
+		return true
+	}
+	rk, ok := remoteKeyFor[key]
+	return ok && RemoteJustPressed(rk)
+}
@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/depeter/jellycouch/internal/config"
+)
+
+const (
+	// remoteLearnSettle is how long a captured event waits before it is
+	// accepted, so a keyboard press seen by Ebitengine can rule it out.
+	remoteLearnSettle = 150 * time.Millisecond
+	// remoteLearnCooldown ignores input after learning, since the learned
+	// press also reaches the editor as a remote button.
+	remoteLearnCooldown = 300 * time.Millisecond
+
+	remoteEditorRowH = 30
+)
+
+// RemoteKeyEditor is a full-screen overlay for assigning evdev key codes to
+// remote buttons. Enter on a button waits for the next remote press and uses
+// its code; Delete/X restores the button's default codes.
+type RemoteKeyEditor struct {
+	input *config.InputConfig
+	index int
+	done  bool
+
+	learning    bool
+	learnSince  time.Time // only events after this count
+	keyboardAt  time.Time // last Ebitengine key press while learning
+	ignoreUntil time.Time
+	message     string
+}
+
+func NewRemoteKeyEditor(input *config.InputConfig) *RemoteKeyEditor {
+	return &RemoteKeyEditor{input: input}
+}
+
+// Done returns true when the editor should close.
+func (re *RemoteKeyEditor) Done() bool { return re.done }
+
+func (re *RemoteKeyEditor) Update() {
+	if re.learning {
+		re.updateLearning()
+		return
+	}
+	if time.Now().Before(re.ignoreUntil) {
+		return
+	}
+
+	dir, enter, back := InputState()
+	if back {
+		re.done = true
+		return
+	}
+	switch dir {
+	case DirUp:
+		if re.index > 0 {
+			re.index--
+		}
+	case DirDown:
+		if re.index < len(RemoteActions)-1 {
+			re.index++
+		}
+	}
+	if enter {
+		re.learning = true
+		re.learnSince = time.Now()
+		re.keyboardAt = time.Time{}
+		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+			re.keyboardAt = re.learnSince // its evdev event may still arrive
+		}
+		re.message = ""
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyDelete) || inpututil.IsKeyJustPressed(ebiten.KeyX) {
+		re.setCodes(nil)
+		re.message = RemoteActions[re.index].Label + " reset to default"
+	}
+}
+
+// updateLearning waits for an evdev press that did not come from a keyboard.
+// Only the keyboard's Esc is read here: remote buttons must not act while
+// they are being learned.
+func (re *RemoteKeyEditor) updateLearning() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		re.learning = false
+		re.message = "Cancelled"
+		re.ignoreUntil = time.Now().Add(remoteLearnCooldown)
+		return
+	}
+	if keys := inpututil.AppendJustPressedKeys(nil); len(keys) > 0 {
+		re.keyboardAt = time.Now()
+	}
+
+	for _, ev := range EvdevRecentEvents() {
+		if !ev.Time.After(re.learnSince) || time.Since(ev.Time) < remoteLearnSettle {
+			continue
+		}
+		if !re.keyboardAt.IsZero() && absDuration(ev.Time.Sub(re.keyboardAt)) < remoteLearnCooldown {
+			re.learnSince = ev.Time // a keyboard key; keep waiting
+			continue
+		}
+		re.setCodes([]int{int(ev.Code)})
+		re.learning = false
+		re.ignoreUntil = time.Now().Add(remoteLearnCooldown)
+		name := ev.Name
+		if name == "" {
+			name = ev.Device
+		}
+		re.message = fmt.Sprintf("%s set to code %d from %s", RemoteActions[re.index].Label, ev.Code, name)
+		return
+	}
+}
+
+// setCodes overrides the focused button's codes; nil restores the defaults.
+func (re *RemoteKeyEditor) setCodes(codes []int) {
+	name := RemoteActions[re.index].Name
+	if codes == nil {
+		delete(re.input.RemoteKeys, name)
+	} else {
+		if re.input.RemoteKeys == nil {
+			re.input.RemoteKeys = make(map[string][]int)
+		}
+		re.input.RemoteKeys[name] = codes
+	}
+	SetRemoteKeyCodes(re.input.RemoteKeys)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// remoteKeysSummary is the settings row value for the remote key overrides.
+func remoteKeysSummary(input *config.InputConfig) string {
+	if len(input.RemoteKeys) == 0 {
+		return "Default"
+	}
+	return fmt.Sprintf("%d changed", len(input.RemoteKeys))
+}
+
+func (re *RemoteKeyEditor) Draw(dst *ebiten.Image) {
+	vector.DrawFilledRect(dst, 0, 0, ScreenWidth, ScreenHeight, ColorOverlay, false)
+
+	panelW := float32(700)
+	panelH := float32(120 + len(RemoteActions)*remoteEditorRowH + 60)
+	panelX := float32(ScreenWidth-panelW) / 2
+	panelY := float32(ScreenHeight-panelH) / 2
+
+	vector.DrawFilledRect(dst, panelX, panelY, panelW, panelH, ColorBackground, false)
+	vector.StrokeRect(dst, panelX, panelY, panelW, panelH, 2, ColorPrimary, false)
+
+	DrawTextCentered(dst, "Remote Buttons", float64(panelX+panelW/2), float64(panelY+24), FontSizeHeading, ColorText)
+	if !remoteDevicesConfigured() {
+		DrawTextCentered(dst, "No remote_devices configured: learned buttons are not used",
+			float64(panelX+panelW/2), float64(panelY+52), FontSizeSmall, ColorError)
+	}
+
+	listX := float64(panelX) + 24
+	codesX := listX + 220
+	y := float64(panelY) + 80
+	for i, a := range RemoteActions {
+		focused := i == re.index
+		if focused {
+			vector.DrawFilledRect(dst, float32(listX-8), float32(y-4), panelW-32, remoteEditorRowH-2, ColorSurfaceHover, false)
+		}
+		labelColor := ColorTextSecondary
+		if focused {
+			labelColor = ColorText
+		}
+		DrawText(dst, a.Label, listX, y, FontSizeBody, labelColor)
+
+		value := formatCodes(RemoteKeyCodes(a.Key))
+		valueColor := ColorTextSecondary
+		if _, custom := re.input.RemoteKeys[a.Name]; custom {
+			valueColor = ColorPrimary
+		}
+		if focused && re.learning {
+			value = "Press a remote button... (Esc to cancel)"
+			valueColor = ColorAccent
+		}
+		DrawText(dst, value, codesX, y, FontSizeBody, valueColor)
+		y += remoteEditorRowH
+	}
+
+	y += 12
+	if re.message != "" {
+		DrawText(dst, re.message, listX, y, FontSizeSmall, ColorSuccess)
+	}
+	DrawText(dst, "Enter learn  \u2022  Delete/X reset  \u2022  Esc close", listX, float64(panelY+panelH)-28, FontSizeSmall, ColorTextMuted)
+}
+
+func formatCodes(codes []int) string {
+	if len(codes) == 0 {
+		return "(none)"
+	}
+	s := make([]string, len(codes))
+	for i, c := range codes {
+		s[i] = fmt.Sprint(c)
+	}
+	return strings.Join(s, ", ")
+}
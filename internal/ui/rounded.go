@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// FocusStyle selects how a focused poster is highlighted.
+type FocusStyle int
+
+const (
+	FocusBorder FocusStyle = iota // accent frame around the poster
+	FocusZoom                     // poster grows a little, no frame
+)
+
+// Poster look — set from config.UI at startup
+var (
+	// CornerRadius rounds the corners of posters and their focus highlight;
+	// 0 draws square corners.
+	CornerRadius = 6.0
+	// PosterFocusStyle is how the focused poster stands out.
+	PosterFocusStyle = FocusBorder
+	// AnimateFocusZoom eases a poster into its FocusZoom size; off, it
+	// jumps there at once, which suits slow devices better.
+	AnimateFocusZoom = true
+	// DimWatched fades watched posters so unwatched ones stand out; the
+	// focused poster is always drawn in full.
+	DimWatched = false
+)
+
+// watchedDim is how far DimWatched fades a watched poster toward the
+// background.
+const watchedDim = 0.6
+
+// focusZoom is how much a poster grows with FocusZoom.
+const focusZoom = 0.08
+
+// ParseFocusStyle maps a ui.focus_style value to a FocusStyle, defaulting to
+// FocusBorder.
+func ParseFocusStyle(s string) FocusStyle {
+	if s == "zoom" {
+		return FocusZoom
+	}
+	return FocusBorder
+}
+
+// appendRoundRect adds a closed rounded rectangle to p.
+func appendRoundRect(p *vector.Path, x, y, w, h, r float32) {
+	r = min(r, w/2, h/2)
+	p.MoveTo(x+r, y)
+	p.LineTo(x+w-r, y)
+	p.ArcTo(x+w, y, x+w, y+r, r)
+	p.LineTo(x+w, y+h-r)
+	p.ArcTo(x+w, y+h, x+w-r, y+h, r)
+	p.LineTo(x+r, y+h)
+	p.ArcTo(x, y+h, x, y+h-r, r)
+	p.LineTo(x, y+r)
+	p.ArcTo(x, y, x+r, y, r)
+	p.Close()
+}
+
+// DrawFilledRoundRect fills a rectangle with corners of radius r. With r <= 0
+// it is vector.DrawFilledRect.
+func DrawFilledRoundRect(dst *ebiten.Image, x, y, w, h, r float32, clr color.Color) {
+	if r <= 0 {
+		vector.DrawFilledRect(dst, x, y, w, h, clr, false)
+		return
+	}
+	var p vector.Path
+	appendRoundRect(&p, x, y, w, h, r)
+	op := &vector.DrawPathOptions{AntiAlias: true}
+	op.ColorScale.ScaleWithColor(clr)
+	vector.FillPath(dst, &p, nil, op)
+}
+
+// clipCorners erases the parts of img outside a w×h rounded rectangle of
+// radius r at its origin.
+func clipCorners(img *ebiten.Image, w, h, r float32) {
+	var p vector.Path
+	p.MoveTo(0, 0)
+	p.LineTo(w, 0)
+	p.LineTo(w, h)
+	p.LineTo(0, h)
+	p.Close()
+	appendRoundRect(&p, 0, 0, w, h, r)
+	op := &vector.DrawPathOptions{AntiAlias: true, Blend: ebiten.BlendDestinationOut}
+	vector.FillPath(img, &p, &vector.FillOptions{FillRule: vector.FillRuleEvenOdd}, op)
+}
+
+// roundScratch holds an offscreen image per poster size, reused every frame
+// to clip posters to rounded corners.
+var roundScratch = map[image.Point]*ebiten.Image{}
+
+// drawRounded calls draw to paint a w×h area at (x, y) of dst, with the
+// area's corners rounded to r. draw must stay within the area, at the
+// position it is given.
+func drawRounded(dst *ebiten.Image, x, y, w, h, r float64, draw func(img *ebiten.Image, x, y float64)) {
+	if r <= 0 {
+		draw(dst, x, y)
+		return
+	}
+	size := image.Pt(int(w)+1, int(h)+1)
+	img := roundScratch[size]
+	if img == nil {
+		img = ebiten.NewImage(size.X, size.Y)
+		roundScratch[size] = img
+	}
+	img.Clear()
+	draw(img, 0, 0)
+	clipCorners(img, float32(w), float32(h), float32(r))
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(x, y)
+	dst.DrawImage(img, op)
+}
@@ -23,11 +23,13 @@ const (
 	TransitionPop
 	TransitionReplace
 	TransitionFocusNavBar // request navbar keyboard focus
+	TransitionConfirm     // ask Confirm before doing something
 )
 
 type ScreenTransition struct {
-	Type   TransitionType
-	Screen Screen // nil for Pop and FocusNavBar
+	Type    TransitionType
+	Screen  Screen         // nil for Pop and FocusNavBar
+	Confirm *ConfirmDialog // TransitionConfirm only
 }
 
 // ScreenManager manages a stack of screens.
@@ -35,6 +37,16 @@ type ScreenManager struct {
 	stack        []Screen
 	NavBar       *NavBar
 	navBarActive bool
+	dialog       *MessageDialog
+	confirm      *ConfirmDialog
+	quit         bool // exit confirmed; Update ends the game
+
+	// On-screen keyboard state; inputs are compared by identity
+	keyboard          OnScreenKeyboard
+	keyboardFor       *TextInput // input the keyboard is open for, nil when closed
+	keyboardDismissed *TextInput // closed for this input until it loses focus
+	keyboardSubmitted bool       // Done queued an OK press for the next frame
+	keyboardSeen      bool       // a physical keyboard typed; never show the keyboard again
 }
 
 func NewScreenManager() *ScreenManager {
@@ -85,7 +97,59 @@ func (sm *ScreenManager) Current() Screen {
 	return sm.stack[len(sm.stack)-1]
 }
 
+// ShowMessage opens a modal notice above the current screen; it takes all
+// input until dismissed. detail may be empty.
+func (sm *ScreenManager) ShowMessage(title, message, detail string) {
+	sm.dialog = &MessageDialog{Title: title, Message: message, Detail: detail}
+}
+
+// ShowConfirm opens a confirmation above the current screen.
+func (sm *ScreenManager) ShowConfirm(cd *ConfirmDialog) {
+	sm.confirm = cd
+}
+
+// requestExit closes the app, asking first when ConfirmExit is set. Back on
+// the last screen comes here rather than leaving an empty stack.
+func (sm *ScreenManager) requestExit() {
+	if !ConfirmExit {
+		sm.quit = true
+		return
+	}
+	sm.confirm = &ConfirmDialog{
+		Title:        T("confirm.exit_title"),
+		Message:      T("confirm.exit_message"),
+		ConfirmLabel: T("confirm.exit"),
+		OnConfirm:    func() { sm.quit = true },
+	}
+}
+
 func (sm *ScreenManager) Update() error {
+	if sm.quit {
+		return ebiten.Termination
+	}
+	if sm.dialog != nil {
+		if sm.dialog.Update() {
+			sm.dialog = nil
+		}
+		return nil
+	}
+	if sm.confirm != nil {
+		if done, button := sm.confirm.Update(); done {
+			cd := sm.confirm
+			sm.confirm = nil
+			switch {
+			case button == 1 && cd.OnConfirm != nil:
+				cd.OnConfirm()
+			case button == 0 && cd.OnCancel != nil:
+				cd.OnCancel()
+			}
+		}
+		return nil
+	}
+	if sm.updateKeyboard() {
+		return nil
+	}
+
 	s := sm.Current()
 	if s == nil {
 		return nil
@@ -123,7 +187,11 @@ func (sm *ScreenManager) Update() error {
 		case TransitionPush:
 			sm.Push(tr.Screen)
 		case TransitionPop:
-			sm.Pop()
+			if len(sm.stack) == 1 {
+				sm.requestExit()
+			} else {
+				sm.Pop()
+			}
 		case TransitionReplace:
 			sm.Replace(tr.Screen)
 		case TransitionFocusNavBar:
@@ -131,6 +199,8 @@ func (sm *ScreenManager) Update() error {
 				sm.navBarActive = true
 				sm.NavBar.FocusFromBelow()
 			}
+		case TransitionConfirm:
+			sm.confirm = tr.Confirm
 		}
 	}
 
@@ -138,6 +208,68 @@ func (sm *ScreenManager) Update() error {
 	return nil
 }
 
+// textEditor returns whatever currently has text focus: the navbar or the
+// current screen.
+func (sm *ScreenManager) textEditor() TextEditor {
+	if sm.navBarActive && sm.NavBar != nil {
+		return sm.NavBar
+	}
+	if te, ok := sm.Current().(TextEditor); ok {
+		return te
+	}
+	return nil
+}
+
+// updateKeyboard opens the on-screen keyboard when a text input gains focus
+// (or OK is pressed on an empty one after closing it) and routes input to it
+// while open. It returns true when the keyboard consumed this frame's input.
+func (sm *ScreenManager) updateKeyboard() bool {
+	if !OnScreenKeyboardEnabled || sm.keyboardSeen {
+		return false
+	}
+	if len(ebiten.AppendInputChars(nil)) > 0 {
+		sm.keyboardSeen = true
+		sm.keyboardFor = nil
+		return false
+	}
+
+	editor := sm.textEditor()
+	var target *TextInput
+	if editor != nil {
+		editor.EditText(func(ti *TextInput) { target = ti })
+	}
+	submitted := sm.keyboardSubmitted
+	sm.keyboardSubmitted = false
+	if target == nil {
+		sm.keyboardFor, sm.keyboardDismissed = nil, nil
+		return false
+	}
+
+	if sm.keyboardFor != target {
+		sm.keyboardFor = nil
+		if target == sm.keyboardDismissed {
+			if submitted || target.Text != "" || !KeyJustPressed(ebiten.KeyEnter) {
+				return false
+			}
+			sm.keyboardFor, sm.keyboardDismissed = target, nil
+			return true // the OK press only opens the keyboard
+		}
+		sm.keyboardFor = target
+	}
+
+	var closed, submit bool
+	editor.EditText(func(ti *TextInput) { closed, submit = sm.keyboard.Update(ti) })
+	if closed {
+		sm.keyboardFor, sm.keyboardDismissed = nil, target
+		if submit {
+			// Submit the text as if OK were pressed on the input itself
+			sm.keyboardSubmitted = true
+			pushRemote(RemoteOK)
+		}
+	}
+	return true
+}
+
 func (sm *ScreenManager) updateNavBarHighlight() {
 	if sm.NavBar == nil {
 		return
@@ -156,6 +288,16 @@ func (sm *ScreenManager) Draw(dst *ebiten.Image) {
 	if sm.NavBar != nil && s != nil && s.Name() != "Login" {
 		sm.NavBar.Draw(dst)
 	}
+	if sm.keyboardFor != nil {
+		sm.keyboard.Draw(dst)
+	}
+	drawToast(dst)
+	if sm.confirm != nil {
+		sm.confirm.Draw(dst)
+	}
+	if sm.dialog != nil {
+		sm.dialog.Draw(dst)
+	}
 }
 
 func (sm *ScreenManager) StackSize() int {
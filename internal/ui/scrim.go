@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// scrimSteps is the number of bands used to approximate a gradient.
+// Ebitengine has no native blur, so readability relies on cheap alpha bands.
+const scrimSteps = 16
+
+// drawVerticalGradient fills a rect with black whose alpha ramps from
+// fromAlpha at the top to toAlpha at the bottom (both 0–1).
+func drawVerticalGradient(dst *ebiten.Image, x, y, w, h float32, fromAlpha, toAlpha float64) {
+	if h <= 0 {
+		return
+	}
+	bandH := h / scrimSteps
+	for i := 0; i < scrimSteps; i++ {
+		t := (float64(i) + 0.5) / scrimSteps
+		a := fromAlpha + (toAlpha-fromAlpha)*t
+		clr := color.RGBA{A: uint8(clampUnit(a) * 0xFF)}
+		vector.DrawFilledRect(dst, x, y+float32(i)*bandH, w, bandH+1, clr, false)
+	}
+}
+
+// drawSectionScrim darkens the band behind a section title at y so labels
+// stay readable over bright artwork. No-op when ContentScrim is disabled.
+func drawSectionScrim(dst *ebiten.Image, y float64) {
+	if !ContentScrim {
+		return
+	}
+	const fadeH = 8
+	top := float32(y) - fadeH
+	drawVerticalGradient(dst, 0, top, ScreenWidth, fadeH, 0, 0.45)
+	vector.DrawFilledRect(dst, 0, float32(y), ScreenWidth, SectionTitleH-fadeH,
+		color.RGBA{A: 0x73}, false)
+	drawVerticalGradient(dst, 0, float32(y)+SectionTitleH-fadeH, ScreenWidth, fadeH, 0.45, 0)
+}
+
+func clampUnit(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
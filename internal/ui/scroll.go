@@ -1,10 +1,19 @@
 package ui
 
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
 // ScrollState provides reusable vertical scroll tracking with smooth animation.
 // Embed this struct in screens that need scrollable content.
 type ScrollState struct {
 	ScrollY       float64
 	TargetScrollY float64
+
+	// Furthest TargetScrollY may go, once SetContentHeight has been called
+	maxScrollY float64
+	bounded    bool
 }
 
 // HandleMouseWheel updates the target scroll position from mouse wheel input.
@@ -13,23 +22,60 @@ func (s *ScrollState) HandleMouseWheel() {
 	_, wy := MouseWheelDelta()
 	if wy != 0 {
 		s.TargetScrollY -= wy * ScrollWheelSpeed
-		if s.TargetScrollY < 0 {
-			s.TargetScrollY = 0
-		}
+		s.clamp()
 	}
 }
 
 // Animate performs smooth scroll interpolation. Call this from Draw().
 func (s *ScrollState) Animate() {
+	s.clamp()
 	s.ScrollY = Lerp(s.ScrollY, s.TargetScrollY, ScrollAnimSpeed)
 }
 
+// SetContentHeight limits scrolling to content contentH tall shown in a
+// viewport viewH tall, so its end can't scroll up past the viewport's
+// bottom. Call it from Draw() once the content is laid out; until then only
+// the top is clamped.
+func (s *ScrollState) SetContentHeight(contentH, viewH float64) {
+	s.maxScrollY = max(0, contentH-viewH)
+	s.bounded = true
+	s.clamp()
+}
+
+// SetGridRows is SetContentHeight for rows rows of rowH pixels starting at
+// top (without scroll offset) and running to the bottom of the screen,
+// leaving bottomPad pixels below the last row.
+func (s *ScrollState) SetGridRows(rows int, rowH, top, bottomPad float64) {
+	s.SetContentHeight(float64(rows)*rowH+bottomPad, float64(ScreenHeight)-top)
+}
+
+// clamp keeps TargetScrollY between the top and the content's end.
+func (s *ScrollState) clamp() {
+	if s.bounded && s.TargetScrollY > s.maxScrollY {
+		s.TargetScrollY = s.maxScrollY
+	}
+	if s.TargetScrollY < 0 {
+		s.TargetScrollY = 0
+	}
+}
+
 // Reset sets scroll position back to top.
 func (s *ScrollState) Reset() {
 	s.ScrollY = 0
 	s.TargetScrollY = 0
 }
 
+// CenterRow scrolls so a row at rowY (on screen, without scroll offset),
+// rowH tall, sits in the middle of the viewport running from viewTop to the
+// bottom of the screen. Near either end of the content the clamps take
+// over, so the first rows stay at the top and the last at the bottom rather
+// than leaving empty space.
+func (s *ScrollState) CenterRow(rowY, rowH, viewTop float64) {
+	viewMid := viewTop + (float64(ScreenHeight)-viewTop)/2
+	s.TargetScrollY = rowY + rowH/2 - viewMid
+	s.clamp()
+}
+
 // EnsureRowVisible scrolls to make the given row index visible in a grid layout.
 // gridBaseY is the top of the grid area (without scroll offset applied).
 // viewHeight is the visible viewport height.
@@ -67,3 +113,30 @@ func (s *ScrollState) EnsureSectionVisible(sectionIdx int, baseY, viewHeight flo
 		}
 	}
 }
+
+// DrawScrollIndicator draws a thin scroll bar at the right edge and a
+// "Row X of Y" hint for a grid of rows rows of rowH pixels starting at top
+// (without scroll offset), with row focused. Nothing is drawn when the grid
+// fits on screen.
+func (s *ScrollState) DrawScrollIndicator(dst *ebiten.Image, top, rowH float64, row, rows int) {
+	viewH := float64(ScreenHeight) - top
+	contentH := float64(rows) * rowH
+	if contentH <= viewH {
+		return
+	}
+
+	const barW = 4.0
+	barX := float64(ScreenWidth) - SectionPadding/2 - barW/2
+	vector.DrawFilledRect(dst, float32(barX), float32(top), barW, float32(viewH), ColorSurface, false)
+	thumbH := max(viewH*viewH/contentH, 40)
+	frac := min(max(s.ScrollY/(contentH-viewH), 0), 1)
+	thumbY := top + frac*(viewH-thumbH)
+	vector.DrawFilledRect(dst, float32(barX), float32(thumbY), barW, float32(thumbH), ColorTextSecondary, false)
+
+	hint := Tf("grid.row_of", min(row, rows-1)+1, rows)
+	tw, _ := MeasureText(hint, FontSizeSmall)
+	hx := barX - 12 - tw
+	hy := float64(ScreenHeight) - FontSizeSmall - 20
+	vector.DrawFilledRect(dst, float32(hx-8), float32(hy-4), float32(tw+16), FontSizeSmall+12, ColorOverlay, false)
+	DrawText(dst, hint, hx, hy, FontSizeSmall, ColorTextSecondary)
+}
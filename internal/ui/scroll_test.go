@@ -0,0 +1,36 @@
+package ui
+
+import "testing"
+
+func TestScrollStateCenterRow(t *testing.T) {
+	const (
+		top  = 200.0 // grid top, without scroll offset
+		rowH = 300.0
+	)
+	viewMid := NavBarHeight + (ScreenHeight-NavBarHeight)/2.0
+
+	tests := []struct {
+		name string
+		rows int // 0 = content height not set yet
+		row  int
+		want float64
+	}{
+		{"first row stays at the top", 10, 0, 0},
+		{"middle row is centered", 10, 4, top + 4*rowH + rowH/2 - viewMid},
+		{"last row stays at the bottom", 10, 9, 10*rowH + 24 - (ScreenHeight - top)},
+		{"content shorter than the viewport", 2, 1, 0},
+		{"unbounded content centers the last row", 0, 9, top + 9*rowH + rowH/2 - viewMid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s ScrollState
+			if tt.rows > 0 {
+				s.SetGridRows(tt.rows, rowH, top, 24)
+			}
+			s.CenterRow(top+float64(tt.row)*rowH, rowH, NavBarHeight)
+			if s.TargetScrollY != tt.want {
+				t.Errorf("TargetScrollY = %v, want %v", s.TargetScrollY, tt.want)
+			}
+		})
+	}
+}
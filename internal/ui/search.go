@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/depeter/jellycouch/internal/cache"
@@ -25,20 +24,24 @@ type SearchScreen struct {
 	searchError string
 
 	searching bool
+	fuzzy     bool // results are close matches, the exact search found nothing
 	ScrollState
 
+	contextMenu *ContextMenu
+
 	OnItemSelected func(item jellyfin.MediaItem)
+	OnPlay         func(item jellyfin.MediaItem, resumeTicks int64)
+	OnRequest4K    func(tmdbID int, mediaType, title string)
 
 	errDisplay ErrorDisplay
 	mu         sync.Mutex
 }
 
 func NewSearchScreen(client *jellyfin.Client, imgCache *cache.ImageCache) *SearchScreen {
-	cols := (ScreenWidth - SectionPadding*2) / (PosterWidth + PosterGap)
 	return &SearchScreen{
 		client:   client,
 		imgCache: imgCache,
-		grid:     NewFocusGrid(cols, 0),
+		grid:     NewPosterFocusGrid(0),
 	}
 }
 
@@ -46,6 +49,16 @@ func (ss *SearchScreen) Name() string { return "Search" }
 func (ss *SearchScreen) OnEnter()     {}
 func (ss *SearchScreen) OnExit()      {}
 
+func (ss *SearchScreen) EditText(edit func(ti *TextInput)) bool {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if ss.focusMode != 0 || ss.contextMenu != nil {
+		return false
+	}
+	edit(&ss.input)
+	return true
+}
+
 // SetInitialQuery sets the search text and triggers a search immediately.
 func (ss *SearchScreen) SetInitialQuery(query string) {
 	ss.input.SetText(query)
@@ -56,6 +69,19 @@ func (ss *SearchScreen) Update() (*ScreenTransition, error) {
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
 
+	// Context menu captures all input while open
+	if ss.contextMenu != nil {
+		ss.contextMenu.Update()
+		if done, action, ok := ss.contextMenu.Done(); done {
+			item, target := ss.contextMenu.Item(), ss.contextMenu.Target()
+			ss.contextMenu = nil
+			if ok {
+				ss.runContextAction(item.ID, action, target)
+			}
+		}
+		return nil, nil
+	}
+
 	_, enter, back := InputState()
 
 	if back {
@@ -114,16 +140,19 @@ func (ss *SearchScreen) Update() (*ScreenTransition, error) {
 		}
 	}
 
-	// Right-click: toggle watched state
+	// Right-click: open context menu for the item under the cursor
 	rmx, rmy, rclicked := MouseJustRightClicked()
-	if rclicked && len(ss.gridItems) > 0 {
+	if rclicked && PosterRightClick != RightClickNone && len(ss.gridItems) > 0 {
 		barY := float64(NavBarHeight) + 20.0
 		barH := 44.0
 		resultBaseY := barY + barH + 40 - ss.ScrollY
 		if idx, ok := ss.grid.HandleClick(rmx, rmy, SectionPadding, resultBaseY); ok {
-			if idx < len(ss.results) {
-				ss.results[idx].Played = ToggleWatched(ss.client, ss.results[idx].ID, ss.results[idx].Played)
-				ss.gridItems[idx].Watched = ss.results[idx].Played
+			ss.focusMode = 1
+			ss.grid.Focused = idx
+			if PosterRightClick == RightClickMenu {
+				ss.openContextMenu(float64(rmx), float64(rmy))
+			} else if idx < len(ss.gridItems) {
+				ss.runContextAction(ss.gridItems[idx].ID, ActionToggleWatched, "")
 			}
 			return nil, nil
 		}
@@ -137,11 +166,11 @@ func (ss *SearchScreen) Update() (*ScreenTransition, error) {
 			go ss.doSearch()
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		if KeyJustPressed(ebiten.KeyArrowUp) {
 			return &ScreenTransition{Type: TransitionFocusNavBar}, nil
 		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) && len(ss.results) > 0 {
+		if KeyJustPressed(ebiten.KeyArrowDown) && len(ss.results) > 0 {
 			ss.focusMode = 1
 		}
 
@@ -155,6 +184,13 @@ func (ss *SearchScreen) Update() (*ScreenTransition, error) {
 			}
 		}
 
+		if ContextMenuKeyPressed() {
+			barY := float64(NavBarHeight) + 20.0
+			barH := 44.0
+			x, y := ss.grid.ItemRect(ss.grid.Focused, SectionPadding, barY+barH+40-ss.ScrollY)
+			ss.openContextMenu(x+ss.grid.PosterW/2, y+ss.grid.PosterH/3)
+		}
+
 		if enter {
 			idx := ss.grid.Focused
 			if idx < len(ss.results) && ss.OnItemSelected != nil {
@@ -166,6 +202,39 @@ func (ss *SearchScreen) Update() (*ScreenTransition, error) {
 	return nil, nil
 }
 
+// openContextMenu opens the context menu for the focused result.
+// Caller must hold ss.mu.
+func (ss *SearchScreen) openContextMenu(x, y float64) {
+	idx := ss.grid.Focused
+	if idx < 0 || idx >= len(ss.gridItems) {
+		return
+	}
+	ss.contextMenu = NewContextMenu(ss.gridItems[idx], x, y, ContextMenuOptions{
+		CanRequest: ss.OnRequest4K != nil,
+		CanRefresh: cachedIsAdmin(ss.client),
+		Playlists:  cachedPlaylists(ss.client),
+	})
+}
+
+// runContextAction applies a context menu action to the result with itemID.
+// Caller must hold ss.mu.
+func (ss *SearchScreen) runContextAction(itemID string, action ContextAction, target string) {
+	for i := range ss.gridItems {
+		if ss.gridItems[i].ID != itemID {
+			continue
+		}
+		runContextAction(ss.client, &ss.gridItems[i], action, target, gridActionCallbacks{
+			OnItemSelected: ss.OnItemSelected,
+			OnPlay:         ss.OnPlay,
+			OnRequest4K:    ss.OnRequest4K,
+		})
+		if i < len(ss.results) {
+			ss.results[i].Played = ss.gridItems[i].Watched
+		}
+		return
+	}
+}
+
 func (ss *SearchScreen) doSearch() {
 	ss.mu.Lock()
 	ss.searching = true
@@ -174,10 +243,17 @@ func (ss *SearchScreen) doSearch() {
 	ss.mu.Unlock()
 
 	items, err := ss.client.SearchItems(query, 40)
+	fuzzy := false
+	if err == nil && len(items) == 0 {
+		// Nothing matched exactly — try tolerating typos
+		items, err = ss.client.FuzzySearch(query, 40)
+		fuzzy = len(items) > 0
+	}
 
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
 	ss.searching = false
+	ss.fuzzy = fuzzy
 
 	if err != nil {
 		ss.searchError = "Search failed: " + err.Error()
@@ -224,11 +300,14 @@ func (ss *SearchScreen) Draw(dst *ebiten.Image) {
 		displayQuery = ss.input.Text
 	}
 	if ss.input.Text == "" && ss.focusMode != 0 {
-		DrawText(dst, "Search...", float64(barX+12), float64(barY+12), FontSizeBody, ColorTextMuted)
+		DrawText(dst, T("nav.search"), float64(barX+12), float64(barY+12), FontSizeBody, ColorTextMuted)
 	} else if ss.input.Text == "" && ss.focusMode == 0 {
-		DrawText(dst, "Search...", float64(barX+12), float64(barY+12), FontSizeBody, ColorTextMuted)
+		DrawText(dst, T("nav.search"), float64(barX+12), float64(barY+12), FontSizeBody, ColorTextMuted)
 		DrawText(dst, displayQuery, float64(barX+12), float64(barY+12), FontSizeBody, ColorText)
 	} else {
+		if ss.focusMode == 0 {
+			ss.input.DrawSelection(dst, float64(barX+12), float64(barY+12), FontSizeBody)
+		}
 		DrawText(dst, displayQuery, float64(barX+12), float64(barY+12), FontSizeBody, ColorText)
 	}
 
@@ -240,7 +319,7 @@ func (ss *SearchScreen) Draw(dst *ebiten.Image) {
 	}
 
 	if ss.searching {
-		DrawText(dst, "Searching...", float64(barX+barW-120), float64(barY+12), FontSizeSmall, ColorTextSecondary)
+		DrawText(dst, T("common.searching"), float64(barX+barW-120), float64(barY+12), FontSizeSmall, ColorTextSecondary)
 	}
 
 	// Result count / error below search bar
@@ -248,7 +327,10 @@ func (ss *SearchScreen) Draw(dst *ebiten.Image) {
 	if ss.searchError != "" {
 		y += ss.errDisplay.Draw(dst, ss.searchError, float64(barX), y, FontSizeSmall)
 	} else if len(ss.results) > 0 {
-		countStr := fmt.Sprintf("%d results", len(ss.results))
+		countStr := fmt.Sprintf("%s results", FormatCount(len(ss.results)))
+		if ss.fuzzy {
+			countStr = Tf("search.close_matches", len(ss.results))
+		}
 		DrawText(dst, countStr, float64(barX), y, FontSizeSmall, ColorTextMuted)
 		y += FontSizeSmall + 8
 	}
@@ -258,21 +340,27 @@ func (ss *SearchScreen) Draw(dst *ebiten.Image) {
 	// Results
 	if len(ss.gridItems) == 0 && !ss.searching {
 		if ss.input.Text != "" && len(ss.results) == 0 && ss.searchError == "" {
-			DrawTextCentered(dst, "No results found", float64(ScreenWidth)/2, y+100,
+			DrawTextCentered(dst, T("common.no_results"), float64(ScreenWidth)/2, y+100,
 				FontSizeHeading, ColorTextSecondary)
 		}
 		return
 	}
 
-	for i, item := range ss.gridItems {
-		x, iy := ss.grid.ItemRect(i, SectionPadding, y-ss.ScrollY)
+	focused := -1
+	if ss.focusMode == 1 {
+		focused = ss.grid.Focused
+	}
+	ss.grid.DrawItems(dst, ss.gridItems, SectionPadding, y-ss.ScrollY, focused)
 
-		// Skip offscreen
-		if iy+PosterHeight < 0 || iy > float64(ScreenHeight) {
-			continue
-		}
+	rows := (len(ss.gridItems) + ss.grid.Cols - 1) / ss.grid.Cols
+	ss.SetGridRows(rows, ss.grid.RowHeight(), y, 24)
+	row := int(ss.ScrollY / ss.grid.RowHeight())
+	if ss.focusMode == 1 {
+		row = ss.grid.FocusedRow()
+	}
+	ss.DrawScrollIndicator(dst, y, ss.grid.RowHeight(), row, rows)
 
-		isFocused := ss.focusMode == 1 && i == ss.grid.Focused
-		drawPosterItem(dst, item, x, iy, isFocused)
+	if ss.contextMenu != nil {
+		ss.contextMenu.Draw(dst)
 	}
 }
@@ -2,13 +2,17 @@ package ui
 
 import (
 	"fmt"
+	"image/color"
+	"math"
 	"strconv"
+	"sync"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/depeter/jellycouch/internal/config"
+	"github.com/depeter/jellycouch/internal/jellyfin"
+	"github.com/depeter/jellycouch/internal/jellyseerr"
 )
 
 // SettingsScreen allows editing configuration.
@@ -27,9 +31,33 @@ type SettingsScreen struct {
 	// Paste button rect (only valid while editing)
 	pasteRect ButtonRect
 
-	langEditor *LangEditor
+	langEditor   *LangEditor
+	remoteEditor *RemoteKeyEditor
+
+	ScrollState
+	shownFocus [2]int // section and item last scrolled into view
+
+	// Jellyseerr connection check, shown next to the section heading
+	seerrStatus string
+	seerrColor  color.Color
+	seerrGen    int
+
+	// Connected Jellyfin server, shown in the Server Info section
+	serverName string
+	serverVer  string
+	serverUser string
+	serverGen  int
+
+	mu sync.Mutex
+
+	// Client is the signed-in Jellyfin client, used for the Server Info
+	// section while the server URL is unchanged.
+	Client *jellyfin.Client
 
 	OnSave func()
+	// OnJellyseerrChanged is called after the Jellyseerr URL or API key is
+	// edited. It returns the reconnected client, or nil if not configured.
+	OnJellyseerrChanged func() *jellyseerr.Client
 }
 
 type settingsRowRect struct {
@@ -44,15 +72,59 @@ type settingsSection struct {
 }
 
 type settingsItem struct {
-	Label     string
-	Value     func() string
-	OnChange  func(val string) error // returns error if validation fails
-	Options   []string               // when set, Left/Right cycles through these instead of text edit
-	MultiLang bool                   // when set, Enter opens multi-language editor overlay
+	Label      string
+	Value      func() string
+	OnChange   func(val string) error // returns error if validation fails
+	Options    []string               // when set, Left/Right cycles through these instead of text edit
+	MultiLang  bool                   // when set, Enter opens multi-language editor overlay
+	RemoteKeys bool                   // when set, Enter opens the remote button editor overlay
+	ReadOnly   bool                   // when set, the value is information and can't be edited
 }
 
 var hwAccelOptions = []string{"auto-safe", "auto", "no", "vaapi", "vdpau", "cuda", "videotoolbox", "d3d11va", "dxva2"}
 
+var onOffOptions = []string{"On", "Off"}
+
+var logLevelOptions = []string{"debug", "info", "warn", "error"}
+
+// Grid Columns is Auto or a fixed count in this range, both in the picker
+// and when typed
+const (
+	minGridColumns = 2
+	maxGridColumns = 12
+)
+
+var gridColumnsOptions = func() []string {
+	opts := []string{"Auto"}
+	for n := minGridColumns; n <= maxGridColumns; n++ {
+		opts = append(opts, strconv.Itoa(n))
+	}
+	return opts
+}()
+
+func gridColumnsLabel(n int) string {
+	if n <= 0 {
+		return "Auto"
+	}
+	return strconv.Itoa(n)
+}
+
+// uiLanguageOptions lists the names of the bundled interface translations.
+func uiLanguageOptions() []string {
+	names := make([]string, len(UILanguages))
+	for i, l := range UILanguages {
+		names[i] = l.Name
+	}
+	return names
+}
+
+func onOff(b bool) string {
+	if b {
+		return "On"
+	}
+	return "Off"
+}
+
 func NewSettingsScreen(cfg *config.Config, onSave func()) *SettingsScreen {
 	ss := &SettingsScreen{
 		cfg:    cfg,
@@ -61,21 +133,50 @@ func NewSettingsScreen(cfg *config.Config, onSave func()) *SettingsScreen {
 
 	ss.sections = []settingsSection{
 		{
-			Label: "Server",
+			Label: T("settings.server"),
 			Items: []settingsItem{
-				{Label: "Server URL", Value: func() string { return cfg.Server.URL }, OnChange: func(v string) error { cfg.Server.URL = v; return nil }},
+				{Label: "Server URL", Value: func() string { return cfg.Server.URL }, OnChange: func(v string) error {
+					cfg.Server.URL = v
+					ss.refreshServerInfo()
+					return nil
+				}},
 				{Label: "Username", Value: func() string { return cfg.Server.Username }, OnChange: func(v string) error { cfg.Server.Username = v; return nil }},
 			},
 		},
+		{
+			Label: T("settings.server_info"),
+			Items: []settingsItem{
+				{Label: "Server Name", Value: func() string { return ss.serverField(&ss.serverName) }, ReadOnly: true},
+				{Label: "Version", Value: func() string { return ss.serverField(&ss.serverVer) }, ReadOnly: true},
+				{Label: "Signed in as", Value: func() string { return ss.serverField(&ss.serverUser) }, ReadOnly: true},
+			},
+		},
 		{
 			Label: "Jellyseerr",
 			Items: []settingsItem{
-				{Label: "URL", Value: func() string { return cfg.Jellyseerr.URL }, OnChange: func(v string) error { cfg.Jellyseerr.URL = v; return nil }},
-				{Label: "API Key", Value: func() string { return cfg.Jellyseerr.APIKey }, OnChange: func(v string) error { cfg.Jellyseerr.APIKey = v; return nil }},
+				{Label: "URL", Value: func() string { return cfg.Jellyseerr.URL }, OnChange: func(v string) error {
+					if v != "" {
+						if _, err := jellyseerr.NormalizeURL(v); err != nil {
+							return err
+						}
+					}
+					cfg.Jellyseerr.URL = v
+					ss.jellyseerrChanged()
+					return nil
+				}},
+				{Label: "API Key", Value: func() string { return cfg.Jellyseerr.APIKey }, OnChange: func(v string) error {
+					cfg.Jellyseerr.APIKey = v
+					ss.jellyseerrChanged()
+					return nil
+				}},
+				{Label: "Hide Available", Value: func() string { return onOff(cfg.Jellyseerr.HideAvailable) }, OnChange: func(v string) error {
+					cfg.Jellyseerr.HideAvailable = v == "On"
+					return nil
+				}, Options: onOffOptions},
 			},
 		},
 		{
-			Label: "Subtitles",
+			Label: T("settings.subtitles"),
 			Items: []settingsItem{
 				{Label: "Font", Value: func() string { return cfg.Subtitles.Font }, OnChange: func(v string) error { cfg.Subtitles.Font = v; return nil }},
 				{Label: "Font Size", Value: func() string { return fmt.Sprintf("%d", cfg.Subtitles.FontSize) }, OnChange: func(v string) error {
@@ -106,7 +207,7 @@ func NewSettingsScreen(cfg *config.Config, onSave func()) *SettingsScreen {
 			},
 		},
 		{
-			Label: "Playback",
+			Label: T("settings.playback"),
 			Items: []settingsItem{
 				{Label: "HW Accel", Value: func() string { return cfg.Playback.HWAccel }, OnChange: func(v string) error { cfg.Playback.HWAccel = v; return nil }, Options: hwAccelOptions},
 				{Label: "Audio Language", Value: func() string { return cfg.Playback.AudioLanguage }, OnChange: func(v string) error { cfg.Playback.AudioLanguage = v; return nil }, MultiLang: true},
@@ -119,21 +220,296 @@ func NewSettingsScreen(cfg *config.Config, onSave func()) *SettingsScreen {
 					cfg.Playback.Volume = n
 					return nil
 				}},
+				{Label: "Trailer Volume", Value: func() string { return fmt.Sprintf("%d", cfg.Playback.TrailerVolume) }, OnChange: func(v string) error {
+					n, err := strconv.Atoi(v)
+					if err != nil || n < 0 || n > 100 {
+						return fmt.Errorf("must be a volume (0-100, 0 = normal): %s", v)
+					}
+					cfg.Playback.TrailerVolume = n
+					return nil
+				}},
+				{Label: "Pause When Unfocused", Value: func() string { return onOff(cfg.Playback.PauseOnFocusLoss) }, OnChange: func(v string) error {
+					cfg.Playback.PauseOnFocusLoss = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Remember Volume", Value: func() string { return onOff(cfg.Playback.RememberVolume) }, OnChange: func(v string) error {
+					cfg.Playback.RememberVolume = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Resume URLs", Value: func() string { return onOff(cfg.Playback.ResumeURLs) }, OnChange: func(v string) error {
+					cfg.Playback.ResumeURLs = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Played At %", Value: func() string { return fmt.Sprintf("%d", cfg.Playback.PlayedThreshold) }, OnChange: func(v string) error {
+					n, err := strconv.Atoi(v)
+					if err != nil || n < 0 || n > 100 {
+						return fmt.Errorf("must be a percentage (0-100): %s", v)
+					}
+					cfg.Playback.PlayedThreshold = n
+					return nil
+				}},
+				{Label: "Rewind On Resume (sec)", Value: func() string { return fmt.Sprintf("%g", cfg.Playback.ResumeRewindSeconds) }, OnChange: func(v string) error {
+					f, err := strconv.ParseFloat(v, 64)
+					if err != nil || f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+						return fmt.Errorf("must be 0 or a positive number of seconds: %s", v)
+					}
+					cfg.Playback.ResumeRewindSeconds = f
+					return nil
+				}},
+				{Label: "Include Specials", Value: func() string { return onOff(cfg.Playback.IncludeSpecials) }, OnChange: func(v string) error {
+					cfg.Playback.IncludeSpecials = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "OSD Hide (sec)", Value: func() string { return fmt.Sprintf("%g", cfg.Playback.OsdHideSeconds) }, OnChange: func(v string) error {
+					f, err := strconv.ParseFloat(v, 64)
+					if err != nil || f <= 0 {
+						return fmt.Errorf("must be a positive number of seconds: %s", v)
+					}
+					cfg.Playback.OsdHideSeconds = f
+					return nil
+				}},
+				{Label: "Progress Line", Value: func() string { return onOff(cfg.Playback.ProgressLine) }, OnChange: func(v string) error {
+					cfg.Playback.ProgressLine = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Overlay Clock", Value: func() string { return onOff(cfg.Playback.ShowClock) }, OnChange: func(v string) error {
+					cfg.Playback.ShowClock = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Battery Level", Value: func() string { return onOff(cfg.Playback.ShowBattery) }, OnChange: func(v string) error {
+					cfg.Playback.ShowBattery = v == "On"
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Preload Player", Value: func() string { return onOff(cfg.Playback.PreloadPlayer) }, OnChange: func(v string) error {
+					cfg.Playback.PreloadPlayer = v == "On" // applies from the next launch
+					return nil
+				}, Options: onOffOptions},
+			},
+		},
+		{
+			Label: T("settings.interface"),
+			Items: []settingsItem{
+				{Label: T("settings.language"), Value: func() string { return Language().Name }, OnChange: func(v string) error {
+					for _, l := range UILanguages {
+						if l.Name == v {
+							cfg.UI.Language = l.Code
+							SetLanguage(l.Code)
+						}
+					}
+					return nil
+				}, Options: uiLanguageOptions()},
+				{Label: "Title Scrim", Value: func() string { return onOff(cfg.UI.ContentScrim) }, OnChange: func(v string) error {
+					cfg.UI.ContentScrim = v == "On"
+					ContentScrim = cfg.UI.ContentScrim
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Backdrop Dim", Value: func() string { return fmt.Sprintf("%.2f", cfg.UI.BackdropDim) }, OnChange: func(v string) error {
+					f, err := strconv.ParseFloat(v, 64)
+					if err != nil || f < 0 || f > 1 {
+						return fmt.Errorf("must be a number between 0 and 1: %s", v)
+					}
+					cfg.UI.BackdropDim = f
+					BackdropDim = f
+					return nil
+				}},
+				{Label: "On-Screen Keyboard", Value: func() string { return onOff(cfg.UI.OnScreenKeyboard) }, OnChange: func(v string) error {
+					cfg.UI.OnScreenKeyboard = v == "On"
+					OnScreenKeyboardEnabled = cfg.UI.OnScreenKeyboard
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Grid Columns", Value: func() string { return gridColumnsLabel(cfg.UI.Columns) }, OnChange: func(v string) error {
+					n := 0
+					if v != "Auto" {
+						var err error
+						if n, err = strconv.Atoi(v); err != nil || n < minGridColumns || n > maxGridColumns {
+							return fmt.Errorf("must be Auto or %d-%d: %s", minGridColumns, maxGridColumns, v)
+						}
+					}
+					cfg.UI.Columns = n
+					GridColumns = n // applies to grids opened from now on
+					return nil
+				}, Options: gridColumnsOptions},
+				{Label: "Corner Radius", Value: func() string { return fmt.Sprintf("%g", cfg.UI.CornerRadius) }, OnChange: func(v string) error {
+					f, err := strconv.ParseFloat(v, 64)
+					if err != nil || f < 0 || f > 40 {
+						return fmt.Errorf("must be a number between 0 and 40: %s", v)
+					}
+					cfg.UI.CornerRadius = f
+					CornerRadius = f
+					return nil
+				}},
+				{Label: "Focus Style", Value: func() string { return cfg.UI.FocusStyle }, OnChange: func(v string) error {
+					cfg.UI.FocusStyle = v
+					PosterFocusStyle = ParseFocusStyle(v)
+					return nil
+				}, Options: []string{"border", "zoom"}},
+				{Label: "Animate Focus Zoom", Value: func() string { return onOff(cfg.UI.AnimateFocus) }, OnChange: func(v string) error {
+					cfg.UI.AnimateFocus = v == "On"
+					AnimateFocusZoom = cfg.UI.AnimateFocus
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Ambient Backdrop", Value: func() string { return onOff(cfg.UI.AmbientBackdrop) }, OnChange: func(v string) error {
+					cfg.UI.AmbientBackdrop = v == "On"
+					AmbientBackdrop = cfg.UI.AmbientBackdrop
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Dim Watched", Value: func() string { return onOff(cfg.UI.DimWatched) }, OnChange: func(v string) error {
+					cfg.UI.DimWatched = v == "On"
+					DimWatched = cfg.UI.DimWatched
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Right Click", Value: func() string { return cfg.UI.RightClickAction }, OnChange: func(v string) error {
+					cfg.UI.RightClickAction = v
+					PosterRightClick = ParseRightClickAction(v)
+					return nil
+				}, Options: []string{"context-menu", "watched", "none"}},
+				{Label: "Confirm Exit", Value: func() string { return onOff(cfg.UI.ConfirmExit) }, OnChange: func(v string) error {
+					cfg.UI.ConfirmExit = v == "On"
+					ConfirmExit = cfg.UI.ConfirmExit
+					return nil
+				}, Options: onOffOptions},
+				{Label: "Log Level", Value: func() string { return cfg.Log.Level }, OnChange: func(v string) error {
+					cfg.Log.Level = v
+					return nil
+				}, Options: logLevelOptions},
 			},
 		},
 	}
 
+	if EvdevSupported {
+		iface := &ss.sections[len(ss.sections)-1]
+		iface.Items = append(iface.Items, settingsItem{
+			Label:      "Remote Buttons",
+			Value:      func() string { return remoteKeysSummary(&cfg.Input) },
+			RemoteKeys: true,
+		})
+	}
+
 	return ss
 }
 
+// AddLatestToggles adds a Home Screen section with a toggle per library for
+// whether its "Latest" row is shown.
+func (ss *SettingsScreen) AddLatestToggles(views []struct{ ID, Name string }) {
+	if len(views) == 0 {
+		return
+	}
+	cfg := ss.cfg
+	section := settingsSection{Label: T("settings.home_screen")}
+	for _, view := range views {
+		id := view.ID
+		section.Items = append(section.Items, settingsItem{
+			Label: Tf("home.latest", view.Name),
+			Value: func() string { return onOff(!cfg.UI.IsLatestHidden(id)) },
+			OnChange: func(v string) error {
+				cfg.UI.SetLatestHidden(id, v == "Off")
+				return nil
+			},
+			Options: onOffOptions,
+		})
+	}
+	ss.sections = append(ss.sections, section)
+}
+
 func (ss *SettingsScreen) Name() string { return "Settings" }
-func (ss *SettingsScreen) OnEnter()     {}
+func (ss *SettingsScreen) OnEnter()     { ss.refreshServerInfo() }
 func (ss *SettingsScreen) OnExit() {
 	if ss.OnSave != nil {
 		ss.OnSave()
 	}
 }
 
+// jellyseerrChanged reconnects Jellyseerr and checks the new settings in the background.
+func (ss *SettingsScreen) jellyseerrChanged() {
+	if ss.OnJellyseerrChanged == nil {
+		return
+	}
+	client := ss.OnJellyseerrChanged()
+
+	ss.mu.Lock()
+	ss.seerrGen++
+	gen := ss.seerrGen
+	ss.seerrColor = ColorTextMuted
+	if client == nil {
+		ss.seerrStatus = "Not configured"
+	} else {
+		ss.seerrStatus = "Checking..."
+	}
+	ss.mu.Unlock()
+
+	if client != nil {
+		go ss.checkJellyseerr(client, gen)
+	}
+}
+
+func (ss *SettingsScreen) checkJellyseerr(client *jellyseerr.Client, gen int) {
+	user, err := client.Validate()
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if gen != ss.seerrGen {
+		return // settings changed again while this check was running
+	}
+	if err != nil {
+		ss.seerrStatus = "Error: " + err.Error()
+		ss.seerrColor = ColorError
+		return
+	}
+	ss.seerrStatus = "Connected"
+	ss.seerrColor = ColorSuccess
+	if user.DisplayName != "" {
+		ss.seerrStatus += " as " + user.DisplayName
+	}
+}
+
+// serverField returns one of the Server Info values under ss.mu.
+func (ss *SettingsScreen) serverField(field *string) string {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return *field
+}
+
+// refreshServerInfo looks up the configured server's name and version, and
+// who is signed in, in the background.
+func (ss *SettingsScreen) refreshServerInfo() {
+	ss.mu.Lock()
+	ss.serverGen++
+	gen := ss.serverGen
+	ss.serverName, ss.serverVer, ss.serverUser = "Checking...", "", ""
+	ss.mu.Unlock()
+
+	// After the URL was edited the signed-in client is for another server;
+	// the new one can still be asked for its public info.
+	client := ss.Client
+	if client == nil || jellyfin.NewClient(ss.cfg.Server.URL).ServerURL() != client.ServerURL() {
+		client = jellyfin.NewClient(ss.cfg.Server.URL)
+	}
+	go ss.checkServer(client, gen)
+}
+
+func (ss *SettingsScreen) checkServer(client *jellyfin.Client, gen int) {
+	info, err := client.GetSystemInfo()
+	user := "Not signed in"
+	if err == nil && client.Token() != "" {
+		if name, uerr := client.CurrentUserName(); uerr != nil {
+			user = "Error: " + uerr.Error()
+		} else {
+			user = name
+		}
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if gen != ss.serverGen {
+		return // the URL changed again while this check was running
+	}
+	if err != nil {
+		ss.serverName = "Unreachable: " + err.Error()
+		return
+	}
+	ss.serverName, ss.serverVer, ss.serverUser = info.ServerName, info.Version, user
+}
+
 // focusedItem returns the currently focused settings item.
 func (ss *SettingsScreen) focusedItem() *settingsItem {
 	return &ss.sections[ss.sectionIndex].Items[ss.itemIndex]
@@ -167,6 +543,23 @@ func (ss *SettingsScreen) openLangEditor(item *settingsItem) {
 	ss.langEditor = NewLangEditor(title, item.Value())
 }
 
+// activate opens the editor for an item: an overlay, the next option, or text edit.
+func (ss *SettingsScreen) activate(item *settingsItem) {
+	switch {
+	case item.ReadOnly:
+	case item.MultiLang:
+		ss.openLangEditor(item)
+	case item.RemoteKeys:
+		ss.remoteEditor = NewRemoteKeyEditor(&ss.cfg.Input)
+	case item.Options != nil:
+		cycleOption(item, 1)
+	default:
+		ss.editInput = NewTextInput(item.Value())
+		ss.editing = true
+		ss.editError = ""
+	}
+}
+
 func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 	// Delegate to lang editor overlay when active
 	if ss.langEditor != nil {
@@ -177,6 +570,13 @@ func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 		}
 		return nil, nil
 	}
+	if ss.remoteEditor != nil {
+		ss.remoteEditor.Update()
+		if ss.remoteEditor.Done() {
+			ss.remoteEditor = nil
+		}
+		return nil, nil
+	}
 
 	_, enter, back := InputState()
 
@@ -188,11 +588,11 @@ func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 		mx, my, clicked := MouseJustClicked()
 		if clicked && PointInRect(mx, my, ss.pasteRect.X, ss.pasteRect.Y, ss.pasteRect.W, ss.pasteRect.H) {
 			if clip := readClipboard(); clip != "" {
-				ss.editInput.insertAtCursor(clip)
+				ss.editInput.Insert(clip)
 				ss.editError = ""
 			}
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if KeyJustPressed(ebiten.KeyEnter) {
 			// Apply edit with validation
 			item := ss.focusedItem()
 			if err := item.OnChange(ss.editInput.Text); err != nil {
@@ -202,7 +602,7 @@ func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 				ss.editError = ""
 			}
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		if KeyJustPressed(ebiten.KeyEscape) {
 			ss.editing = false
 			ss.editError = ""
 		}
@@ -213,6 +613,8 @@ func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 		return &ScreenTransition{Type: TransitionPop}, nil
 	}
 
+	ss.ScrollState.HandleMouseWheel()
+
 	// Mouse click handling
 	mx, my, clicked := MouseJustClicked()
 	if clicked {
@@ -220,17 +622,7 @@ func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 			if PointInRect(mx, my, rect.X, rect.Y, rect.W, rect.H) {
 				ss.sectionIndex = rect.SectionIdx
 				ss.itemIndex = rect.ItemIdx
-				item := ss.focusedItem()
-				if item.MultiLang {
-					ss.openLangEditor(item)
-				} else if item.Options != nil {
-					// Cycle forward on click
-					cycleOption(item, 1)
-				} else {
-					ss.editInput = NewTextInput(item.Value())
-					ss.editing = true
-					ss.editError = ""
-				}
+				ss.activate(ss.focusedItem())
 				return nil, nil
 			}
 		}
@@ -275,30 +667,24 @@ func (ss *SettingsScreen) Update() (*ScreenTransition, error) {
 	}
 
 	if enter {
-		item := ss.focusedItem()
-		if item.MultiLang {
-			ss.openLangEditor(item)
-		} else if item.Options != nil {
-			// Cycle forward on Enter for option items
-			cycleOption(item, 1)
-		} else {
-			ss.editInput = NewTextInput(item.Value())
-			ss.editing = true
-			ss.editError = ""
-		}
+		ss.activate(ss.focusedItem())
 	}
 
 	return nil, nil
 }
 
 func (ss *SettingsScreen) Draw(dst *ebiten.Image) {
-	DrawText(dst, "Settings", SectionPadding, NavBarHeight+16, FontSizeTitle, ColorText)
+	ss.ScrollState.Animate()
+	DrawText(dst, T("settings.title"), SectionPadding, NavBarHeight+16-ss.ScrollY, FontSizeTitle, ColorText)
 
-	y := float64(NavBarHeight*2 + 10)
+	y := float64(NavBarHeight*2+10) - ss.ScrollY
 	ss.rowRects = ss.rowRects[:0] // reset
 
 	for si, sec := range ss.sections {
 		DrawText(dst, sec.Label, SectionPadding, y, FontSizeHeading, ColorPrimary)
+		if sec.Label == "Jellyseerr" {
+			ss.drawJellyseerrStatus(dst, sec.Label, y)
+		}
 		y += FontSizeHeading + 8
 
 		for ii, item := range sec.Items {
@@ -312,6 +698,10 @@ func (ss *SettingsScreen) Draw(dst *ebiten.Image) {
 				SectionIdx: si, ItemIdx: ii,
 				X: rowX, Y: y - 4, W: rowW, H: float64(rowH),
 			})
+			if isFocused && ss.shownFocus != [2]int{si, ii} {
+				ss.shownFocus = [2]int{si, ii}
+				ss.keepRowVisible(y+ss.ScrollY, float64(rowH))
+			}
 
 			if isFocused {
 				vector.DrawFilledRect(dst, float32(rowX), float32(y-4),
@@ -372,6 +762,9 @@ func (ss *SettingsScreen) Draw(dst *ebiten.Image) {
 				if isFocused && !isEditing {
 					valueColor = ColorText
 				}
+				if isEditing {
+					ss.editInput.DrawSelection(dst, valueX, y+4, FontSizeBody)
+				}
 				DrawText(dst, value, valueX, y+4, FontSizeBody, valueColor)
 			}
 
@@ -385,8 +778,41 @@ func (ss *SettingsScreen) Draw(dst *ebiten.Image) {
 		y += 16
 	}
 
-	// Draw lang editor overlay on top
+	// Stop the mouse wheel scrolling past the end of the list
+	ss.SetContentHeight(y+ss.ScrollY+24, float64(ScreenHeight))
+
+	// Draw editor overlays on top
 	if ss.langEditor != nil {
 		ss.langEditor.Draw(dst)
 	}
+	if ss.remoteEditor != nil {
+		ss.remoteEditor.Draw(dst)
+	}
+}
+
+// keepRowVisible scrolls so the focused row (at unscrolled y) is on screen.
+func (ss *SettingsScreen) keepRowVisible(y, rowH float64) {
+	top := float64(NavBarHeight + 16)
+	bottom := float64(ScreenHeight) - 24
+	if y-ss.TargetScrollY < top {
+		ss.TargetScrollY = y - top
+	} else if y+rowH-ss.TargetScrollY > bottom {
+		ss.TargetScrollY = y + rowH - bottom
+	}
+	if ss.TargetScrollY < 0 {
+		ss.TargetScrollY = 0
+	}
+}
+
+// drawJellyseerrStatus draws the last connection check result after the section heading.
+func (ss *SettingsScreen) drawJellyseerrStatus(dst *ebiten.Image, heading string, y float64) {
+	ss.mu.Lock()
+	status, clr := ss.seerrStatus, ss.seerrColor
+	ss.mu.Unlock()
+	if status == "" {
+		return
+	}
+
+	w, _ := MeasureText(heading, FontSizeHeading)
+	DrawText(dst, status, SectionPadding+w+24, y+6, FontSizeSmall, clr)
 }
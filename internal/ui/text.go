@@ -4,79 +4,172 @@ import (
 	"bytes"
 	"image/color"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
 var (
-	fontSource *text.GoTextFaceSource
-	fontFaces  map[float64]*text.GoTextFace
+	// fontSources holds the built-in font first, then fallbacks for glyphs it
+	// lacks (CJK, Arabic, Hebrew, ...), in priority order.
+	fontSources []*text.GoTextFaceSource
+	fontFaces   map[faceKey]text.Face
 )
 
+type faceKey struct {
+	size float64
+	rtl  bool
+}
+
 func InitFonts(ttfData []byte) error {
 	src, err := text.NewGoTextFaceSource(bytes.NewReader(ttfData))
 	if err != nil {
 		return err
 	}
-	fontSource = src
-	fontFaces = make(map[float64]*text.GoTextFace)
+	fontSources = []*text.GoTextFaceSource{src}
+	fontFaces = make(map[faceKey]text.Face)
 	return nil
 }
 
-func GetFace(size float64) *text.GoTextFace {
-	if face, ok := fontFaces[size]; ok {
+// GetFace returns the left-to-right face for size.
+func GetFace(size float64) text.Face {
+	return getFace(size, false)
+}
+
+// getFace returns a face for size that falls back through fontSources for
+// missing glyphs. rtl selects right-to-left shaping.
+func getFace(size float64, rtl bool) text.Face {
+	key := faceKey{size, rtl}
+	if face, ok := fontFaces[key]; ok {
 		return face
 	}
-	face := &text.GoTextFace{
-		Source: fontSource,
-		Size:   size,
+	dir := text.DirectionLeftToRight
+	if rtl {
+		dir = text.DirectionRightToLeft
 	}
-	fontFaces[size] = face
+	faces := make([]text.Face, len(fontSources))
+	for i, src := range fontSources {
+		faces[i] = &text.GoTextFace{Source: src, Size: size, Direction: dir}
+	}
+	var face text.Face = faces[0]
+	if len(faces) > 1 {
+		if mf, err := text.NewMultiFace(faces...); err == nil {
+			face = mf
+		}
+	}
+	fontFaces[key] = face
 	return face
 }
 
+// faceFor returns the face to draw txt with: right-to-left if its first
+// strong character is from an RTL script.
+func faceFor(txt string, size float64) text.Face {
+	return getFace(size, isRTL(txt))
+}
+
+func isRTL(txt string) bool {
+	for _, r := range txt {
+		if unicode.In(r, unicode.Arabic, unicode.Hebrew, unicode.Syriac, unicode.Thaana, unicode.Nko) {
+			return true
+		}
+		if unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return false
+}
+
 func DrawText(dst *ebiten.Image, txt string, x, y float64, size float64, clr color.Color) {
-	face := GetFace(size)
+	rtl := isRTL(txt)
+	face := getFace(size, rtl)
 	op := &text.DrawOptions{}
+	if rtl {
+		// RTL text is laid out leftwards from its origin
+		w, _ := text.Measure(txt, face, 0)
+		x += w
+	}
 	op.GeoM.Translate(x, y)
 	op.ColorScale.ScaleWithColor(clr)
 	text.Draw(dst, txt, face, op)
 }
 
 func DrawTextCentered(dst *ebiten.Image, txt string, cx, cy float64, size float64, clr color.Color) {
-	face := GetFace(size)
-	w, h := text.Measure(txt, face, 0)
+	w, h := MeasureText(txt, size)
 	DrawText(dst, txt, cx-w/2, cy-h/2, size, clr)
 }
 
 func MeasureText(txt string, size float64) (float64, float64) {
-	face := GetFace(size)
-	return text.Measure(txt, face, 0)
+	return text.Measure(txt, faceFor(txt, size), 0)
 }
 
 func DrawTextWrapped(dst *ebiten.Image, txt string, x, y, maxWidth float64, size float64, clr color.Color) float64 {
-	face := GetFace(size)
-	lineHeight := face.Size * 1.4
+	lineHeight := size * 1.4
+	lines := wrapLines(txt, maxWidth, size)
+	for i, line := range lines {
+		DrawText(dst, line, x, y+float64(i)*lineHeight, size, clr)
+	}
+	return float64(len(lines)) * lineHeight
+}
+
+// MeasureTextWrapped returns the height DrawTextWrapped would use.
+func MeasureTextWrapped(txt string, maxWidth, size float64) float64 {
+	return float64(len(wrapLines(txt, maxWidth, size))) * size * 1.4
+}
+
+// wrapLines splits txt into lines no wider than maxWidth, breaking at spaces.
+// A word wider than maxWidth (e.g. CJK text, which has no spaces) is broken
+// between characters.
+func wrapLines(txt string, maxWidth, size float64) []string {
 	words := strings.Fields(txt)
 	if len(words) == 0 {
-		return 0
+		return nil
 	}
 
-	line := words[0]
-	cy := y
-	for _, word := range words[1:] {
-		test := line + " " + word
-		w, _ := text.Measure(test, face, 0)
-		if w > maxWidth {
-			DrawText(dst, line, x, cy, size, clr)
-			cy += lineHeight
-			line = word
-		} else {
+	var lines []string
+	line := ""
+	for _, word := range words {
+		test := word
+		if line != "" {
+			test = line + " " + word
+		}
+		if w, _ := MeasureText(test, size); w <= maxWidth {
 			line = test
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
 		}
+		line = word
+		for {
+			if w, _ := MeasureText(line, size); w <= maxWidth {
+				break
+			}
+			head, rest := splitToWidth(line, maxWidth, size)
+			lines = append(lines, head)
+			line = rest
+		}
+	}
+	return append(lines, line)
+}
+
+// splitToWidth splits s after the most characters that fit in maxWidth
+// (at least one).
+func splitToWidth(s string, maxWidth, size float64) (string, string) {
+	end := 0
+	for i := range s {
+		if i == 0 {
+			continue
+		}
+		if w, _ := MeasureText(s[:i], size); w > maxWidth {
+			break
+		}
+		end = i
+	}
+	if end == 0 {
+		_, n := utf8.DecodeRuneInString(s)
+		end = n
 	}
-	DrawText(dst, line, x, cy, size, clr)
-	cy += lineHeight
-	return cy - y
+	return s[:end], s[end:]
 }
@@ -6,12 +6,19 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
-// TextInput handles text editing with cursor navigation.
+// TextInput handles text editing with cursor navigation, selection and the
+// clipboard. Ctrl (or Cmd) with Left/Right moves by word, with Backspace or
+// Delete removes a word; Shift extends the selection.
 type TextInput struct {
 	Text   string
-	Cursor int // rune position within Text
+	Cursor int  // rune position within Text
+	Secret bool // never copy or cut the text (passwords)
+
+	anchor    int  // other end of the selection, in runes
+	selecting bool // anchor is set; the selection is between anchor and Cursor
 }
 
 // NewTextInput creates a TextInput initialized with the given text and cursor at the end.
@@ -26,42 +33,73 @@ func NewTextInput(text string) TextInput {
 func (ti *TextInput) SetText(text string) {
 	ti.Text = text
 	ti.Cursor = utf8.RuneCountInString(text)
+	ti.selecting = false
 }
 
 // Clear resets the text and cursor.
 func (ti *TextInput) Clear() {
 	ti.Text = ""
 	ti.Cursor = 0
+	ti.selecting = false
 }
 
 // Update processes input events. Returns true if the text changed.
 func (ti *TextInput) Update() bool {
 	changed := false
 	runeCount := utf8.RuneCountInString(ti.Text)
+	ctrl := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	shift := ebiten.IsKeyPressed(ebiten.KeyShift)
 
 	// Cursor movement
 	if inputRepeating(ebiten.KeyArrowLeft) {
-		if ti.Cursor > 0 {
-			ti.Cursor--
+		switch {
+		case ctrl:
+			ti.moveTo(ti.wordStartBefore(ti.Cursor), shift)
+		case ti.HasSelection() && !shift:
+			start, _ := ti.Selection()
+			ti.moveTo(start, false)
+		case ti.Cursor > 0:
+			ti.moveTo(ti.Cursor-1, shift)
 		}
 	}
 	if inputRepeating(ebiten.KeyArrowRight) {
-		if ti.Cursor < runeCount {
-			ti.Cursor++
+		switch {
+		case ctrl:
+			ti.moveTo(ti.wordEndAfter(ti.Cursor), shift)
+		case ti.HasSelection() && !shift:
+			_, end := ti.Selection()
+			ti.moveTo(end, false)
+		case ti.Cursor < runeCount:
+			ti.moveTo(ti.Cursor+1, shift)
 		}
 	}
 	if inpututil.IsKeyJustPressed(ebiten.KeyHome) {
-		ti.Cursor = 0
+		ti.moveTo(0, shift)
 	}
 	if inpututil.IsKeyJustPressed(ebiten.KeyEnd) {
-		ti.Cursor = runeCount
+		ti.moveTo(runeCount, shift)
 	}
 
-	// Ctrl+V paste from clipboard
-	if inpututil.IsKeyJustPressed(ebiten.KeyV) && (ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)) {
-		if clip := readClipboard(); clip != "" {
-			ti.insertAtCursor(clip)
-			changed = true
+	if ctrl {
+		switch {
+		case inpututil.IsKeyJustPressed(ebiten.KeyA):
+			ti.SelectAll()
+		case inpututil.IsKeyJustPressed(ebiten.KeyC):
+			if s := ti.SelectedText(); s != "" && !ti.Secret {
+				writeClipboard(s)
+			}
+		case inpututil.IsKeyJustPressed(ebiten.KeyX):
+			if s := ti.SelectedText(); s != "" && !ti.Secret {
+				writeClipboard(s)
+				ti.deleteSelection()
+				changed = true
+			}
+		case inpututil.IsKeyJustPressed(ebiten.KeyV):
+			// Ctrl+V paste from clipboard
+			if clip := readClipboard(); clip != "" {
+				ti.Insert(clip)
+				changed = true
+			}
 		}
 	}
 
@@ -69,27 +107,42 @@ func (ti *TextInput) Update() bool {
 	runes := ebiten.AppendInputChars(nil)
 	for _, r := range runes {
 		if !unicode.IsControl(r) {
-			ti.insertAtCursor(string(r))
+			ti.Insert(string(r))
 			changed = true
 		}
 	}
 
-	// Backspace — delete before cursor
-	if inputRepeating(ebiten.KeyBackspace) && ti.Cursor > 0 {
-		before, after := ti.splitAtCursor()
-		_, size := utf8.DecodeLastRuneInString(before)
-		ti.Text = before[:len(before)-size] + after
-		ti.Cursor--
-		changed = true
+	// Backspace — delete the selection, or the rune/word before the cursor
+	if inputRepeating(ebiten.KeyBackspace) {
+		switch {
+		case ti.HasSelection():
+			ti.deleteSelection()
+			changed = true
+		case ti.Cursor > 0:
+			start := ti.Cursor - 1
+			if ctrl {
+				start = ti.wordStartBefore(ti.Cursor)
+			}
+			ti.deleteRange(start, ti.Cursor)
+			changed = true
+		}
 	}
 
-	// Delete — delete after cursor
-	if inputRepeating(ebiten.KeyDelete) && ti.Cursor < runeCount {
-		_, after := ti.splitAtCursor()
-		before := ti.Text[:len(ti.Text)-len(after)]
-		_, size := utf8.DecodeRuneInString(after)
-		ti.Text = before + after[size:]
-		changed = true
+	// Delete — delete the selection, or the rune/word after the cursor
+	if inputRepeating(ebiten.KeyDelete) {
+		runeCount = utf8.RuneCountInString(ti.Text)
+		switch {
+		case ti.HasSelection():
+			ti.deleteSelection()
+			changed = true
+		case ti.Cursor < runeCount:
+			end := ti.Cursor + 1
+			if ctrl {
+				end = ti.wordEndAfter(ti.Cursor)
+			}
+			ti.deleteRange(ti.Cursor, end)
+			changed = true
+		}
 	}
 
 	return changed
@@ -101,12 +154,115 @@ func (ti *TextInput) DisplayText() string {
 	return before + "│" + after
 }
 
-func (ti *TextInput) insertAtCursor(s string) {
+// DrawSelection highlights the selected text behind DisplayText drawn at x, y.
+func (ti *TextInput) DrawSelection(dst *ebiten.Image, x, y, size float64) {
+	if !ti.HasSelection() {
+		return
+	}
+	start, end := ti.Selection()
+	// DisplayText has the cursor glyph at Cursor, which is one selection end
+	if ti.Cursor == start {
+		start++
+		end++
+	}
+	display := []rune(ti.DisplayText())
+	x0, _ := MeasureText(string(display[:start]), size)
+	x1, _ := MeasureText(string(display[:end]), size)
+	vector.DrawFilledRect(dst, float32(x+x0), float32(y-2), float32(x1-x0), float32(size*1.3),
+		ColorPrimaryDark, false)
+}
+
+// Insert replaces the selection (if any) with s and moves the cursor after it.
+func (ti *TextInput) Insert(s string) {
+	ti.deleteSelection()
 	before, after := ti.splitAtCursor()
 	ti.Text = before + s + after
 	ti.Cursor += utf8.RuneCountInString(s)
 }
 
+// HasSelection reports whether any text is selected.
+func (ti *TextInput) HasSelection() bool {
+	return ti.selecting && ti.anchor != ti.Cursor
+}
+
+// Selection returns the selected rune range [start, end).
+func (ti *TextInput) Selection() (start, end int) {
+	if !ti.HasSelection() {
+		return ti.Cursor, ti.Cursor
+	}
+	return min(ti.anchor, ti.Cursor), max(ti.anchor, ti.Cursor)
+}
+
+// SelectedText returns the selected text, or "" if nothing is selected.
+func (ti *TextInput) SelectedText() string {
+	start, end := ti.Selection()
+	return string([]rune(ti.Text)[start:end])
+}
+
+// SelectAll selects the whole text.
+func (ti *TextInput) SelectAll() {
+	ti.anchor = 0
+	ti.Cursor = utf8.RuneCountInString(ti.Text)
+	ti.selecting = true
+}
+
+// moveTo moves the cursor to pos, extending the selection if extend is set
+// and dropping it otherwise.
+func (ti *TextInput) moveTo(pos int, extend bool) {
+	if extend && !ti.selecting {
+		ti.anchor = ti.Cursor
+		ti.selecting = true
+	} else if !extend {
+		ti.selecting = false
+	}
+	ti.Cursor = pos
+}
+
+func (ti *TextInput) deleteSelection() {
+	if ti.HasSelection() {
+		ti.deleteRange(ti.Selection())
+	}
+	ti.selecting = false
+}
+
+// deleteRange removes runes [start, end) and leaves the cursor at start.
+func (ti *TextInput) deleteRange(start, end int) {
+	runes := []rune(ti.Text)
+	ti.Text = string(runes[:start]) + string(runes[end:])
+	ti.Cursor = start
+	ti.selecting = false
+}
+
+// wordStartBefore returns the start of the word before pos, skipping any
+// separators immediately before it.
+func (ti *TextInput) wordStartBefore(pos int) int {
+	runes := []rune(ti.Text)
+	for pos > 0 && !isWordRune(runes[pos-1]) {
+		pos--
+	}
+	for pos > 0 && isWordRune(runes[pos-1]) {
+		pos--
+	}
+	return pos
+}
+
+// wordEndAfter returns the end of the word after pos, skipping any
+// separators immediately after it.
+func (ti *TextInput) wordEndAfter(pos int) int {
+	runes := []rune(ti.Text)
+	for pos < len(runes) && !isWordRune(runes[pos]) {
+		pos++
+	}
+	for pos < len(runes) && isWordRune(runes[pos]) {
+		pos++
+	}
+	return pos
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
 // splitAtCursor returns the text before and after the cursor position.
 func (ti *TextInput) splitAtCursor() (before, after string) {
 	bytePos := 0
@@ -0,0 +1,133 @@
+package ui
+
+import "testing"
+
+func TestTextInputMoveTo(t *testing.T) {
+	ti := NewTextInput("hello world")
+	ti.Cursor = 2
+
+	ti.moveTo(5, true)
+	if start, end := ti.Selection(); start != 2 || end != 5 {
+		t.Fatalf("extend from 2 to 5: selection = [%d, %d), want [2, 5)", start, end)
+	}
+	// Extending again keeps the original anchor, also when crossing it
+	ti.moveTo(0, true)
+	if start, end := ti.Selection(); start != 0 || end != 2 {
+		t.Fatalf("extend back to 0: selection = [%d, %d), want [0, 2)", start, end)
+	}
+	ti.moveTo(7, false)
+	if ti.HasSelection() || ti.Cursor != 7 {
+		t.Fatalf("plain move: cursor = %d, selection = %v; want 7, none", ti.Cursor, ti.HasSelection())
+	}
+	ti.moveTo(7, true)
+	if ti.HasSelection() {
+		t.Fatalf("extend to the cursor itself selects %q", ti.SelectedText())
+	}
+}
+
+func TestTextInputWordStartBefore(t *testing.T) {
+	tests := []struct {
+		text string
+		pos  int
+		want int
+	}{
+		{"hello world", 11, 6},
+		{"hello world", 8, 6},
+		{"hello world", 6, 0},
+		{"hello   world", 8, 0},
+		{"foo, bar", 5, 0},
+		{"hello", 0, 0},
+		{"", 0, 0},
+		{"café olé", 8, 5},
+		{"café olé", 5, 0},
+	}
+	for _, tt := range tests {
+		ti := NewTextInput(tt.text)
+		if got := ti.wordStartBefore(tt.pos); got != tt.want {
+			t.Errorf("wordStartBefore(%q, %d) = %d, want %d", tt.text, tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestTextInputWordEndAfter(t *testing.T) {
+	tests := []struct {
+		text string
+		pos  int
+		want int
+	}{
+		{"hello world", 0, 5},
+		{"hello world", 2, 5},
+		{"hello world", 5, 11},
+		{"hello   world", 5, 13},
+		{"foo, bar", 3, 8},
+		{"hello", 5, 5},
+		{"", 0, 0},
+		{"café olé", 0, 4},
+		{"café olé", 4, 8},
+	}
+	for _, tt := range tests {
+		ti := NewTextInput(tt.text)
+		if got := ti.wordEndAfter(tt.pos); got != tt.want {
+			t.Errorf("wordEndAfter(%q, %d) = %d, want %d", tt.text, tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestTextInputDeleteRange(t *testing.T) {
+	tests := []struct {
+		text       string
+		start, end int
+		want       string
+	}{
+		{"hello world", 5, 11, "hello"},
+		{"hello world", 0, 6, "world"},
+		{"hello", 2, 2, "hello"},
+		{"café olé", 3, 6, "caflé"},
+	}
+	for _, tt := range tests {
+		ti := NewTextInput(tt.text)
+		ti.SelectAll()
+		ti.deleteRange(tt.start, tt.end)
+		if ti.Text != tt.want || ti.Cursor != tt.start || ti.HasSelection() {
+			t.Errorf("deleteRange(%q, %d, %d) = %q, cursor %d, selection %v; want %q, cursor %d, none",
+				tt.text, tt.start, tt.end, ti.Text, ti.Cursor, ti.HasSelection(), tt.want, tt.start)
+		}
+	}
+}
+
+func TestTextInputInsert(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		cursor     int
+		selectFrom int // -1 = no selection
+		insert     string
+		want       string
+		wantCursor int
+	}{
+		{"at end", "hello", 5, -1, " world", "hello world", 11},
+		{"at start", "world", 0, -1, "hello ", "hello world", 6},
+		{"after multibyte runes", "café", 3, -1, "X", "cafXé", 4},
+		{"multibyte insert", "ab", 1, -1, "éé", "aééb", 3},
+		{"replaces selection", "hello world", 11, 6, "there", "hello there", 11},
+		{"replaces selection made backwards", "hello world", 0, 5, "howdy", "howdy world", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := NewTextInput(tt.text)
+			ti.Cursor = tt.cursor
+			if tt.selectFrom >= 0 {
+				ti.Cursor = tt.selectFrom
+				ti.moveTo(tt.cursor, true)
+			}
+			ti.Insert(tt.insert)
+			if ti.Text != tt.want || ti.Cursor != tt.wantCursor {
+				t.Errorf("Insert(%q) = %q, cursor %d; want %q, cursor %d",
+					tt.insert, ti.Text, ti.Cursor, tt.want, tt.wantCursor)
+			}
+			if ti.HasSelection() {
+				t.Errorf("Insert(%q) left %q selected", tt.insert, ti.SelectedText())
+			}
+		})
+	}
+}
@@ -20,6 +20,19 @@ var (
 	ColorRatingGold    = color.RGBA{R: 0xFF, G: 0xD7, B: 0x00, A: 0xFF}
 )
 
+// Readability overlays — set from config.UI at startup
+var (
+	// ContentScrim draws a darkening band behind section titles on home and discover.
+	ContentScrim = true
+	// BackdropDim is the opacity (0–1) of the gradient drawn over detail backdrops.
+	BackdropDim = 0.75
+	// HitPadding grows click targets beyond their drawn bounds, for imprecise
+	// pointers such as TV remotes or air mice.
+	HitPadding = 6.0
+	// AmbientBackdrop fades the focused item's backdrop in behind the home screen.
+	AmbientBackdrop = true
+)
+
 // Layout constants
 const (
 	PosterWidth     = 220
@@ -27,6 +40,10 @@ const (
 	PosterGap       = 28
 	PosterFocusPad  = 8
 
+	// Episode cards are landscape, the shape of an episode still
+	EpisodeCardWidth  = 320
+	EpisodeCardHeight = 180
+
 	BackdropHeight  = 400
 
 	SectionPadding  = 40
@@ -53,6 +70,8 @@ const (
 	GridRowHeight = PosterHeight + PosterGap + FontSizeSmall + FontSizeCaption + 16
 	// SectionRowHeight is the height of a PosterGrid row including focus padding.
 	SectionRowHeight = PosterHeight + FontSizeSmall + FontSizeCaption + 24 + PosterFocusPad*2
+	// PosterItemHeight is a poster plus its title and subtitle labels.
+	PosterItemHeight = PosterHeight + 6 + FontSizeSmall + 4 + FontSizeCaption
 	// SectionFullHeight is a PosterGrid section including title and gap.
 	SectionFullHeight = SectionRowHeight + SectionTitleH + SectionGap
 
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// toastDuration is how long a toast stays on screen.
+const toastDuration = 3 * time.Second
+
+const (
+	toastPadX = 24.0
+	toastPadY = 14.0
+)
+
+// toast is the short notice shown at the bottom of the screen. It is set from
+// background goroutines, so it has its own lock.
+var toast struct {
+	mu    sync.Mutex
+	text  string
+	until time.Time
+}
+
+// ShowToast shows text briefly at the bottom of the screen, above every
+// screen but without taking input. It is safe to call from any goroutine.
+func ShowToast(text string) {
+	toast.mu.Lock()
+	defer toast.mu.Unlock()
+	toast.text = text
+	toast.until = time.Now().Add(toastDuration)
+}
+
+// drawToast draws the current toast, if it has not expired.
+func drawToast(dst *ebiten.Image) {
+	toast.mu.Lock()
+	text, until := toast.text, toast.until
+	toast.mu.Unlock()
+	if text == "" || time.Now().After(until) {
+		return
+	}
+
+	tw, _ := MeasureText(text, FontSizeBody)
+	w := tw + toastPadX*2
+	h := FontSizeBody + toastPadY*2
+	x := (float64(ScreenWidth) - w) / 2
+	y := float64(ScreenHeight) - h - 60
+	vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), float32(h), ColorSurface, false)
+	vector.StrokeRect(dst, float32(x), float32(y), float32(w), float32(h), 2, ColorPrimary, false)
+	DrawText(dst, text, x+toastPadX, y+toastPadY, FontSizeBody, ColorText)
+}
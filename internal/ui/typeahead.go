@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// typeAheadPause is how long after the last keystroke a type-ahead string
+// is forgotten.
+const typeAheadPause = time.Second
+
+// typeAhead buffers letters typed in quick succession into a prefix to jump
+// to, like a file explorer.
+type typeAhead struct {
+	buf  string
+	last time.Time
+}
+
+// active reports whether a type-ahead string is still being typed, so
+// further letters extend it rather than starting over.
+func (ta *typeAhead) active() bool {
+	return ta.buf != "" && time.Since(ta.last) < typeAheadPause
+}
+
+// add appends typed characters to the buffer, starting over after a pause.
+// It returns the prefix to look for, "" when nothing was added, and whether
+// the search should start after the current item: a new string, or the same
+// letter typed again to cycle through the items starting with it.
+func (ta *typeAhead) add(chars []rune) (prefix string, next bool) {
+	if !ta.active() {
+		ta.buf = ""
+	}
+	before := ta.buf
+	for _, r := range chars {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || (r == ' ' && ta.buf != "") ||
+			(unicode.IsPunct(r) && ta.buf != "") {
+			ta.buf += string(unicode.ToLower(r))
+		}
+	}
+	if ta.buf == before {
+		return "", false
+	}
+	ta.last = time.Now()
+
+	// "sss" cycles through the s's rather than looking for "sss"
+	if first := []rune(ta.buf)[0]; strings.Trim(ta.buf, string(first)) == "" {
+		return string(first), true
+	}
+	return ta.buf, before == ""
+}
+
+// typeAheadMatch returns the index of the first name starting with prefix,
+// searching from start (or the one after it, with next) and wrapping
+// around, or -1 if none does.
+func typeAheadMatch(names []string, start int, prefix string, next bool) int {
+	if next {
+		start++
+	}
+	for i := range names {
+		idx := (start + i) % len(names)
+		if strings.HasPrefix(strings.ToLower(names[idx]), prefix) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// draw shows the string being typed near the bottom of the screen.
+func (ta *typeAhead) draw(dst *ebiten.Image) {
+	if !ta.active() {
+		return
+	}
+	tw, _ := MeasureText(ta.buf, FontSizeHeading)
+	w, h := tw+40, float64(FontSizeHeading+20)
+	x, y := (float64(ScreenWidth)-w)/2, float64(ScreenHeight)-h-60
+	vector.DrawFilledRect(dst, float32(x), float32(y), float32(w), float32(h), ColorSurface, false)
+	vector.StrokeRect(dst, float32(x), float32(y), float32(w), float32(h), 1, ColorPrimary, false)
+	DrawTextCentered(dst, ta.buf, x+w/2, y+h/2, FontSizeHeading, ColorText)
+}